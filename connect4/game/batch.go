@@ -0,0 +1,57 @@
+package game
+
+import (
+	"runtime"
+	"sync"
+)
+
+// GameSeed derives the seed of game number gameNdx in a batch from the seed of the whole batch
+// Nearby batch seeds and game numbers give unrelated game seeds, and the result is never zero.
+func GameSeed(batchSeed int64, gameNdx int) int64 {
+	// splitmix64
+	z := uint64(batchSeed) + uint64(gameNdx+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	z ^= z >> 31
+
+	seed := int64(z >> 1)
+	if seed == 0 {
+		seed = 1
+	}
+
+	return seed
+}
+
+// PlayBatch plays every game in configs on up to workers goroutines, GOMAXPROCS of them when workers is less than 1
+//
+// results[i] is the result of configs[i], so a batch of seeded games without time controls
+// gives the same results however many workers play it.
+// Every config should have its own Output, or none, as the games are printed at the same time.
+func PlayBatch(configs []GameConfig, workers int) []GameResult {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(configs))
+
+	results := make([]GameResult, len(configs))
+	gameNdxs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for gameNdx := range gameNdxs {
+				results[gameNdx] = PlayConnect4(configs[gameNdx])
+			}
+		}()
+	}
+
+	for gameNdx := range configs {
+		gameNdxs <- gameNdx
+	}
+	close(gameNdxs)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,37 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlayBatchGivesTheSameResultsInParallel(t *testing.T) {
+	configs := make([]GameConfig, 12)
+	for ndx := range configs {
+		configs[ndx] = NewDefaultGameConfig()
+		configs[ndx].Player1 = "random"
+		configs[ndx].Player2 = "mcts"
+		configs[ndx].Seed = GameSeed(42, ndx)
+		configs[ndx].Output = nil
+	}
+
+	serial := PlayBatch(configs, 1)
+	parallel := PlayBatch(configs, 8)
+
+	for ndx := range configs {
+		if serial[ndx].Winner != parallel[ndx].Winner || !reflect.DeepEqual(serial[ndx].Turns, parallel[ndx].Turns) {
+			t.Errorf(`TestPlayBatchGivesTheSameResultsInParallel game %d was %v in serial but %v in parallel`, ndx, serial[ndx].Turns, parallel[ndx].Turns)
+		}
+	}
+}
+
+func TestGameSeedIsNeverZero(t *testing.T) {
+	seeds := map[int64]bool{}
+	for gameNdx := range 1000 {
+		seed := GameSeed(0, gameNdx)
+		if seed == 0 || seeds[seed] {
+			t.Errorf(`TestGameSeedIsNeverZero game %d has the seed %d which is zero or repeated`, gameNdx, seed)
+		}
+		seeds[seed] = true
+	}
+}
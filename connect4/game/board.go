@@ -3,69 +3,232 @@ package game
 import (
 	"errors"
 	"fmt"
+	"slices"
 )
 
 type GameBoardActions interface {
 	AvailableRow(column int) int
+	GetHeight() int
 	GetSpaceOwnership(column int, row int) int
 	GetTurnHistory() []RecordedTurn
+	GetWidth() int
+	GetWinningLength() int
 	IsPlayersSpace(player PlayerStrategy, column int, row int) bool
 	IsVictory() int
-	PrintGameBoard(turn int)
+	WinningLines() []WinningLine
+	Clone() PlayableGameBoard
+	Snapshot() BoardSnapshot
+}
+
+// PlayableGameBoard is a GameBoardActions that pieces can be played on
+//
+// Search strategies play on a Clone of the board they are given, using PlayHypothetical and Undo
+// to try a move and take it back. PlayPiece plays a move for real, it can not be undone.
+type PlayableGameBoard interface {
+	GameBoardActions
+	PlayPiece(playerValue int, column int) error
+	PlayPieceAndCheck(playerValue int, column int) (bool, error)
+	PlayHypothetical(playerValue int, column int) error
+	PlayHypotheticalAndCheck(playerValue int, column int) (bool, error)
+	Undo() error
+	Redo() error
+}
+
+// engineBoard is a PlayableGameBoard that PlayConnect4 can also correct, every board in this package is one
+type engineBoard interface {
+	PlayableGameBoard
+	recordIllegalAttempts(columns []int)
+	takeBack(turns int) error
 }
 
 type GameBoard struct {
-	board       [BoardWidth][BoardHeight]int
-	turnHistory []RecordedTurn
+	board         [][]int
+	width         int
+	height        int
+	winningLength int
+	turnHistory   []RecordedTurn
+	hypothetical  hypotheticalMoves
+	cellsShared   bool // a snapshot shares the cells, they are copied before a piece is played or taken back
+	historyShared bool // a snapshot shares the turn history, it is copied before a turn in it changes
 }
 
 type RecordedTurn struct {
-	PlayerValue int
-	Column      int
-	Row         int
+	PlayerValue     int
+	Column          int
+	Row             int
+	IllegalAttempts []int // columns the player chose before this one that could not be played
 }
 
+var ErrColumnOutOfRange = errors.New("column out of range")
+var ErrColumnFull = errors.New("column is full")
+var ErrBoardFull = errors.New("board is full")
+var ErrNothingToUndo = errors.New("no hypothetical move to undo")
+var ErrNothingToRedo = errors.New("no undone move to redo")
+
 func NewGameBoard() *GameBoard {
+	return NewGameBoardOfSize(BoardWidth, BoardHeight, WinningLength)
+}
+
+func NewGameBoardOfSize(width int, height int, winningLength int) *GameBoard {
+	if width < 1 || height < 1 || winningLength < 1 {
+		panic(fmt.Sprintf("invalid board size %dx%d connect %d", width, height, winningLength))
+	}
+
 	// [x][y] board coordinates, the value is player ownership
-	gameBoard := [BoardWidth][BoardHeight]int{
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1},
+	gameBoard := make([][]int, width)
+	for x := range width {
+		gameBoard[x] = make([]int, height)
+		for y := range height {
+			gameBoard[x][y] = NoPlayer
+		}
 	}
 
-	return &GameBoard{board: gameBoard, turnHistory: []RecordedTurn{}}
+	return &GameBoard{
+		board:         gameBoard,
+		width:         width,
+		height:        height,
+		winningLength: winningLength,
+		turnHistory:   []RecordedTurn{},
+	}
 }
 
+// NewInProgressGameBoard builds a standard board from a matrix listed top to bottom
+// It panics if the position could not have come from a real game, see Validate
 func NewInProgressGameBoard(matrix [BoardHeight][BoardWidth]int) *GameBoard {
 	// [x][y] board coordinates, the value is player ownership
 	//
 	// For testing readability, it is easier to visually read a transposed matrix
-	return &GameBoard{board: TransposeMatrix(matrix), turnHistory: []RecordedTurn{}}
+	gameBoard := &GameBoard{
+		board:         TransposeMatrix(matrix),
+		width:         BoardWidth,
+		height:        BoardHeight,
+		winningLength: WinningLength,
+		turnHistory:   []RecordedTurn{},
+	}
+
+	if err := gameBoard.Validate(); err != nil {
+		panic(err)
+	}
+
+	return gameBoard
 }
 
-func TransposeMatrix(matrix [BoardHeight][BoardWidth]int) [BoardWidth][BoardHeight]int {
+// NewInProgressGameBoardOfSize builds a board of any size from rows listed top to bottom
+// It panics if the position could not have come from a real game, see Validate
+func NewInProgressGameBoardOfSize(rows [][]int, winningLength int) *GameBoard {
+	gameBoard := newUncheckedInProgressGameBoard(rows, winningLength)
+
+	if err := gameBoard.Validate(); err != nil {
+		panic(err)
+	}
+
+	return gameBoard
+}
+
+func newUncheckedInProgressGameBoard(rows [][]int, winningLength int) *GameBoard {
+	// rows are listed top to bottom, the same way NewInProgressGameBoard reads them
+	// every row must have the same number of columns
+	height := len(rows)
+	if height == 0 || len(rows[0]) == 0 {
+		panic("an in progress board needs at least one row and one column")
+	}
+	width := len(rows[0])
+
+	for _, row := range rows {
+		if len(row) != width {
+			panic(fmt.Sprintf("every row must have %d columns", width))
+		}
+	}
+
+	return &GameBoard{
+		board:         transposeRows(rows),
+		width:         width,
+		height:        height,
+		winningLength: winningLength,
+		turnHistory:   []RecordedTurn{},
+	}
+}
+
+func TransposeMatrix(matrix [BoardHeight][BoardWidth]int) [][]int {
 	// This function is used to help visualize the board in code
 	// Pass the resulting Transposed Matrix to the constructor of the GameBoard
-	rows := len(matrix)
-	cols := len(matrix[0])
+	rows := make([][]int, len(matrix))
+	for i := range matrix {
+		rows[i] = matrix[i][:]
+	}
 
-	var transposed [BoardWidth][BoardHeight]int
-	for i := 0; i < rows; i++ {
-		for j := 0; j < cols; j++ {
-			transposed[j][i] = matrix[i][j]
+	return transposeRows(rows)
+}
+
+func transposeRows(rows [][]int) [][]int {
+	height := len(rows)
+	width := len(rows[0])
+
+	transposed := make([][]int, width)
+	for j := 0; j < width; j++ {
+		transposed[j] = make([]int, height)
+		for i := 0; i < height; i++ {
+			transposed[j][i] = rows[i][j]
 		}
 	}
 
 	return transposed
 }
 
+// Clone returns a deep copy that can be played on without changing this board
+func (gameBoard GameBoard) Clone() PlayableGameBoard {
+	clone := gameBoard
+	clone.board = copyCells(gameBoard.board)
+	clone.turnHistory = copyTurnHistory(gameBoard.turnHistory)
+	clone.hypothetical = gameBoard.hypothetical.copy()
+	clone.cellsShared = false
+	clone.historyShared = false
+
+	return &clone
+}
+
+// Snapshot returns a read only copy of the board that shares its cells and turn history
+// The board copies what it shares before it next changes it, see ownCells and ownHistory.
+func (gameBoard *GameBoard) Snapshot() BoardSnapshot {
+	gameBoard.cellsShared = true
+	gameBoard.historyShared = true
+
+	shared := *gameBoard
+	shared.turnHistory = slices.Clip(gameBoard.turnHistory)
+	shared.hypothetical = gameBoard.hypothetical.copy()
+
+	return BoardSnapshot{board: &shared}
+}
+
+func copyCells(cells [][]int) [][]int {
+	copied := make([][]int, len(cells))
+	for x := range cells {
+		copied[x] = append([]int(nil), cells[x]...)
+	}
+
+	return copied
+}
+
+// ownCells copies the cells before they change if a snapshot shares them
+func (gameBoard *GameBoard) ownCells() {
+	if gameBoard.cellsShared {
+		gameBoard.board = copyCells(gameBoard.board)
+		gameBoard.cellsShared = false
+	}
+}
+
+// ownHistory copies the turn history before a turn in it changes if a snapshot shares it
+// Appending a turn does not need a copy, the snapshot's history ends before it.
+func (gameBoard *GameBoard) ownHistory() {
+	if gameBoard.historyShared {
+		gameBoard.turnHistory = slices.Clone(gameBoard.turnHistory)
+		gameBoard.historyShared = false
+	}
+}
+
 func (gameboard GameBoard) AvailableRow(column int) int {
 	// Returns a StatusRowIsFull if the xSlot is full
-	height := BoardHeight
+	height := gameboard.height
 
 	if gameboard.board[column][0] != NoPlayer {
 		return StatusRowIsFull // The column is full
@@ -84,258 +247,202 @@ func (gameboard GameBoard) AvailableRow(column int) int {
 	return StatusRowIsFull
 }
 
+func (gameBoard GameBoard) GetHeight() int {
+	return gameBoard.height
+}
+
 func (gameBoard GameBoard) GetSpaceOwnership(column int, row int) int {
 	// returns NoPlayer if owned by neither player
 	return gameBoard.board[column][row]
 }
 
+// GetTurnHistory returns a copy of the turns played, changing it does not change the board
 func (gameBoard GameBoard) GetTurnHistory() []RecordedTurn {
-	return gameBoard.turnHistory
+	return copyTurnHistory(gameBoard.turnHistory)
+}
+
+func (gameBoard GameBoard) GetWidth() int {
+	return gameBoard.width
+}
+
+func (gameBoard GameBoard) GetWinningLength() int {
+	return gameBoard.winningLength
 }
 
 func (gameBoard GameBoard) IsPlayersSpace(player PlayerStrategy, column int, row int) bool {
 	return player.GetPlayerValue() == gameBoard.board[column][row]
 }
 
+// IsVictory scans every lane of the board, see boardLanes, and returns the owner of the first connection found
 func (gameBoard GameBoard) IsVictory() int {
-	winner := gameBoard.IsHorizontalVictory()
-	if winner != NoPlayer {
-		return winner
-	}
-
-	winner = gameBoard.IsVerticalVictory()
-	if winner != NoPlayer {
-		return winner
-	}
+	return victoryInLanes(gameBoard, gameBoard.lanes())
+}
 
-	winner = gameBoard.IsDiagonalVictory()
-	if winner != NoPlayer {
-		return winner
-	}
+// WinningLines lists every run of winningLength or more pieces, with its cells and direction
+func (gameBoard GameBoard) WinningLines() []WinningLine {
+	return findWinningLines(&gameBoard)
+}
 
-	return NoPlayer
+func (gameBoard GameBoard) lanes() []Lane {
+	return boardLanes(gameBoard.width, gameBoard.height, gameBoard.winningLength)
 }
 
 func (gameBoard GameBoard) IsHorizontalVictory() int {
-	for row := range BoardHeight {
-		owner := gameBoard.IsHorizontalVictoryInRow(row)
-
-		if owner != NoPlayer {
-			return owner
-		}
-	}
-
-	return NoPlayer
+	return victoryInLanes(gameBoard, gameBoard.lanes(), DirectionHorizontal)
 }
 
 func (gameBoard GameBoard) IsHorizontalVictoryInRow(row int) int {
-	piecesInARow := 0
-	prevSpaceOwner := NoPlayer
-
-	for x := range BoardWidth {
-		owner := gameBoard.board[x][row]
-
-		if owner == NoPlayer {
-			prevSpaceOwner = NoPlayer
-			piecesInARow = 0
-			continue
-		}
-
-		if owner == prevSpaceOwner {
-			piecesInARow++
-			if piecesInARow >= 4 {
-				return owner
-			}
-		} else {
-			piecesInARow = 1
-			prevSpaceOwner = owner
-		}
-	}
-
-	return NoPlayer
+	return laneWinner(gameBoard, laneFrom(gameBoard.width, gameBoard.height, 0, row, directionSteps[0]))
 }
 
 func (gameBoard GameBoard) IsVerticalVictory() int {
-	for column := range BoardWidth {
-		owner := gameBoard.IsVerticalVictoryInColumn(column)
-
-		if owner != NoPlayer {
-			return owner
-		}
-	}
-
-	return NoPlayer
+	return victoryInLanes(gameBoard, gameBoard.lanes(), DirectionVertical)
 }
 
 func (gameBoard GameBoard) IsVerticalVictoryInColumn(column int) int {
-	piecesInARow := 0
-	prevSpaceOwner := NoPlayer
-
-	for y := range BoardHeight {
-		owner := gameBoard.board[column][y]
+	return laneWinner(gameBoard, laneFrom(gameBoard.width, gameBoard.height, column, 0, directionSteps[1]))
+}
 
-		if owner == NoPlayer {
-			prevSpaceOwner = NoPlayer
-			piecesInARow = 0
-			continue
-		}
+func (gameBoard GameBoard) IsDiagonalVictory() int {
+	return victoryInLanes(gameBoard, gameBoard.lanes(), DirectionDiagonalDown, DirectionDiagonalUp)
+}
 
-		if owner == prevSpaceOwner {
-			piecesInARow++
-			if piecesInARow >= 4 {
-				return owner
-			}
-		} else {
-			piecesInARow = 1
-			prevSpaceOwner = owner
-		}
-	}
+// IsDiagonalVictoryDownLeftLane checks the diagonal from the cell down and to the left
+func (gameBoard GameBoard) IsDiagonalVictoryDownLeftLane(xStart int, yStart int) int {
+	downLeft := directionStep{direction: DirectionDiagonalUp, column: -1, row: 1}
+	return laneWinner(gameBoard, laneFrom(gameBoard.width, gameBoard.height, xStart, yStart, downLeft))
+}
 
-	return NoPlayer
+// IsDiagonalVictoryDownRightLane checks the diagonal from the cell down and to the right
+func (gameBoard GameBoard) IsDiagonalVictoryDownRightLane(xStart int, yStart int) int {
+	return laneWinner(gameBoard, laneFrom(gameBoard.width, gameBoard.height, xStart, yStart, directionSteps[2]))
 }
 
-func (gameBoard GameBoard) IsDiagonalVictory() int {
+func (gameBoard *GameBoard) PlayPiece(playerValue int, column int) error {
+	// Returns ErrBoardFull, ErrColumnOutOfRange or ErrColumnFull without changing the board if the move is illegal
+	if err := gameBoard.PlayHypothetical(playerValue, column); err != nil {
+		return err
+	}
 
-	for x := range BoardWidth - WinningLength - 1 {
-		winner := gameBoard.IsDiagonalVictoryDownRightLane(x, 0)
+	gameBoard.hypothetical.commit()
 
-		if winner != NoPlayer {
-			return winner
-		}
+	return nil
+}
+
+// PlayPieceAndCheck is PlayPiece that also reports whether the piece made a connection
+// Only the four lines through the new piece are checked, not the whole board like IsVictory
+func (gameBoard *GameBoard) PlayPieceAndCheck(playerValue int, column int) (bool, error) {
+	if err := gameBoard.PlayPiece(playerValue, column); err != nil {
+		return false, err
 	}
 
-	for y := 1; y < BoardWidth-WinningLength-1; y++ {
-		winner := gameBoard.IsDiagonalVictoryDownRightLane(0, y)
+	return connectsThroughLastTurn(gameBoard, gameBoard.turnHistory), nil
+}
 
-		if winner != NoPlayer {
-			return winner
-		}
+// PlayHypotheticalAndCheck is PlayHypothetical that also reports whether the piece made a connection
+func (gameBoard *GameBoard) PlayHypotheticalAndCheck(playerValue int, column int) (bool, error) {
+	if err := gameBoard.PlayHypothetical(playerValue, column); err != nil {
+		return false, err
 	}
 
-	for x := WinningLength - 1; x < BoardWidth; x++ {
-		winner := gameBoard.IsDiagonalVictoryDownLeftLane(x, 0)
+	return connectsThroughLastTurn(gameBoard, gameBoard.turnHistory), nil
+}
 
-		if winner != NoPlayer {
-			return winner
-		}
+// PlayHypothetical plays a piece that Undo can take back
+func (gameBoard *GameBoard) PlayHypothetical(playerValue int, column int) error {
+	if err := checkMove(gameBoard, column); err != nil {
+		return err
 	}
 
-	for y := 1; y < BoardHeight-(WinningLength-1); y++ {
-		winner := gameBoard.IsDiagonalVictoryDownLeftLane(BoardWidth-1, y)
+	row := gameBoard.AvailableRow(column)
+	gameBoard.ownCells()
+	gameBoard.board[column][row] = playerValue
 
-		if winner != NoPlayer {
-			return winner
-		}
-	}
+	thisTurn := RecordedTurn{PlayerValue: playerValue, Column: column, Row: row}
+	gameBoard.turnHistory = append(gameBoard.turnHistory, thisTurn)
+	gameBoard.hypothetical.played()
 
-	return NoPlayer
+	return nil
 }
 
-func (gameBoard GameBoard) IsDiagonalVictoryDownLeftLane(xStart int, yStart int) int {
-	spacesToCheck := min(xStart+1, BoardHeight-yStart)
-	piecesInARow := 0
-	prevSpaceOwner := NoPlayer
+// Undo takes back the last hypothetical move, returning ErrNothingToUndo if there is none
+func (gameBoard *GameBoard) Undo() error {
+	if !gameBoard.hypothetical.canUndo() {
+		return ErrNothingToUndo
+	}
 
-	for spaceNdx := range spacesToCheck {
-		owner := gameBoard.board[xStart-spaceNdx][yStart+spaceNdx]
+	gameBoard.hypothetical.undone(gameBoard.removeLastTurn())
 
-		if owner == NoPlayer {
-			prevSpaceOwner = NoPlayer
-			piecesInARow = 0
-			continue
-		}
+	return nil
+}
 
-		if owner == prevSpaceOwner {
-			piecesInARow++
-			if piecesInARow >= WinningLength {
-				return owner
-			}
-		} else {
-			piecesInARow = 1
-			prevSpaceOwner = owner
-		}
+// takeBack removes the last turns played for real, when a player's request to undo is granted
+func (gameBoard *GameBoard) takeBack(turns int) error {
+	if turns > len(gameBoard.turnHistory) {
+		return ErrNothingToUndo
 	}
 
-	return NoPlayer
-}
+	for range turns {
+		gameBoard.removeLastTurn()
+	}
+	gameBoard.hypothetical.commit()
 
-func (gameBoard GameBoard) IsDiagonalVictoryDownRightLane(xStart int, yStart int) int {
-	spacesToCheck := min(BoardWidth-xStart, BoardHeight-yStart)
-	piecesInARow := 0
-	prevSpaceOwner := NoPlayer
+	return nil
+}
 
-	for spaceNdx := range spacesToCheck {
-		owner := gameBoard.board[xStart+spaceNdx][yStart+spaceNdx]
+func (gameBoard *GameBoard) removeLastTurn() RecordedTurn {
+	gameBoard.ownCells()
+	gameBoard.ownHistory()
+	lastTurn := gameBoard.turnHistory[len(gameBoard.turnHistory)-1]
+	gameBoard.board[lastTurn.Column][lastTurn.Row] = NoPlayer
+	gameBoard.turnHistory = gameBoard.turnHistory[:len(gameBoard.turnHistory)-1]
 
-		if owner == NoPlayer {
-			prevSpaceOwner = NoPlayer
-			piecesInARow = 0
-			continue
-		}
+	return lastTurn
+}
 
-		if owner == prevSpaceOwner {
-			piecesInARow++
-			if piecesInARow >= WinningLength {
-				return owner
-			}
-		} else {
-			piecesInARow = 1
-			prevSpaceOwner = owner
-		}
-	}
+// Redo plays the last undone move again, returning ErrNothingToRedo if there is none
+func (gameBoard *GameBoard) Redo() error {
+	return gameBoard.hypothetical.redo(gameBoard)
+}
 
-	return NoPlayer
+func (gameBoard *GameBoard) recordIllegalAttempts(columns []int) {
+	gameBoard.ownHistory()
+	recordIllegalAttempts(gameBoard.turnHistory, columns)
 }
 
-func (gameBoard *GameBoard) PlayPiece(playerValue int, column int) error {
-	// If an invalid row is requested to be played, a FirstAvailableMove is used for the move
-
-	row := StatusRowIsFull
-	if column > 0 && column < BoardWidth {
-		row = gameBoard.AvailableRow(column)
-	} // else the requested slot is out of bounds
-
-	if row == StatusRowIsFull {
-		defaultStrategy := NewPlayerStrategyFirstAvailableMove(playerValue)
-		column = defaultStrategy.PlayerChoosesAMove(*gameBoard)
-		if column == StatusNoAvailableMove {
-			return errors.New("no available move")
-		}
-		row = gameBoard.AvailableRow(column)
+// checkMove reports why a piece cannot be dropped in the column, or nil if it can
+func checkMove(gameBoard GameBoardActions, column int) error {
+	if isBoardFull(gameBoard) {
+		return ErrBoardFull
 	}
 
-	gameBoard.board[column][row] = playerValue
+	if column < 0 || column >= gameBoard.GetWidth() {
+		return fmt.Errorf("%w: column %d is not between 0 and %d", ErrColumnOutOfRange, column, gameBoard.GetWidth()-1)
+	}
 
-	thisTurn := RecordedTurn{PlayerValue: playerValue, Column: column, Row: row}
-	gameBoard.turnHistory = append(gameBoard.turnHistory, thisTurn)
+	if gameBoard.AvailableRow(column) == StatusRowIsFull {
+		return fmt.Errorf("%w: column %d", ErrColumnFull, column)
+	}
 
 	return nil
 }
 
-func (gameBoard GameBoard) PrintGameBoard(turn int) {
-	width := BoardWidth
-	height := BoardHeight
-
-	for y := range height {
-		fmt.Print("|  ")
-		for x := range width {
-			owner := gameBoard.board[x][y]
-
-			if owner == NoPlayer {
-				fmt.Print(`_  `)
-			} else {
-				fmt.Printf(`%d  `, owner)
-			}
+func isBoardFull(gameBoard GameBoardActions) bool {
+	for column := range gameBoard.GetWidth() {
+		if gameBoard.AvailableRow(column) != StatusRowIsFull {
+			return false
 		}
-		fmt.Println("|")
 	}
-	fmt.Println("|-----------------------|")
 
-	spacing := ""
-	if turn < 10 {
-		spacing = " "
+	return true
+}
+
+// recordIllegalAttempts notes the illegal columns chosen before the last turn in the history was played
+func recordIllegalAttempts(turnHistory []RecordedTurn, columns []int) {
+	if len(turnHistory) == 0 || len(columns) == 0 {
+		return
 	}
-	fmt.Printf("|        Turn  %v%d       |", spacing, turn)
-	fmt.Println("")
-	fmt.Println("")
+
+	lastTurn := &turnHistory[len(turnHistory)-1]
+	lastTurn.IllegalAttempts = append(lastTurn.IllegalAttempts, columns...)
 }
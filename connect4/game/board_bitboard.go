@@ -0,0 +1,357 @@
+package game
+
+import (
+	"fmt"
+	"slices"
+)
+
+// BitBoard is a GameBoardActions backed by one uint64 per player
+//
+// Every column uses height+1 bits, bottom row first. The extra bit on top of each column
+// is always empty so that shifting a run of pieces can never wrap into the next column.
+// With the standard 7x6 board that is 49 of the 64 available bits.
+type BitBoard struct {
+	playerValues  [NumPlayers]int
+	pieces        [NumPlayers]uint64
+	columnHeights []int
+	width         int
+	height        int
+	winningLength int
+	turnHistory   []RecordedTurn
+	hypothetical  hypotheticalMoves
+	cellsShared   bool // a snapshot shares the column heights, they are copied before a piece is played or taken back
+	historyShared bool // a snapshot shares the turn history, it is copied before a turn in it changes
+}
+
+func NewBitBoard(width int, height int, winningLength int, playerValues [NumPlayers]int) (*BitBoard, error) {
+	if width < 1 || height < 1 || winningLength < 1 {
+		return nil, fmt.Errorf("invalid board size %dx%d connect %d", width, height, winningLength)
+	}
+
+	if !BitBoardFits(width, height) {
+		return nil, fmt.Errorf("a %dx%d board needs %d bits but a bitboard only has 64", width, height, width*(height+1))
+	}
+
+	if playerValues[0] == playerValues[1] || playerValues[0] == NoPlayer || playerValues[1] == NoPlayer {
+		return nil, fmt.Errorf("a bitboard needs two distinct player values, got %v", playerValues)
+	}
+
+	return &BitBoard{
+		playerValues:  playerValues,
+		columnHeights: make([]int, width),
+		width:         width,
+		height:        height,
+		winningLength: winningLength,
+		turnHistory:   []RecordedTurn{},
+	}, nil
+}
+
+// newBitBoardFromActions copies any GameBoardActions or BoardView into a BitBoard without turn history
+// Pieces owned by playerValues[0] go to the first bitboard, every other owner goes to the second
+func newBitBoardFromActions(gba boardReader, playerValues [NumPlayers]int) (*BitBoard, error) {
+	bitBoard, err := NewBitBoard(gba.GetWidth(), gba.GetHeight(), gba.GetWinningLength(), playerValues)
+	if err != nil {
+		return nil, err
+	}
+
+	for column := range bitBoard.width {
+		for row := range bitBoard.height {
+			owner := gba.GetSpaceOwnership(column, row)
+			if owner == NoPlayer {
+				continue
+			}
+
+			playerNdx := 1
+			if owner == playerValues[0] {
+				playerNdx = 0
+			}
+			bitBoard.pieces[playerNdx] |= bitBoard.cellBit(column, row)
+		}
+
+		availableRow := gba.AvailableRow(column)
+		if availableRow == StatusRowIsFull {
+			bitBoard.columnHeights[column] = bitBoard.height
+		} else {
+			bitBoard.columnHeights[column] = bitBoard.height - 1 - availableRow
+		}
+	}
+
+	return bitBoard, nil
+}
+
+// BitBoardFits reports whether a board of this size can be stored in a BitBoard
+func BitBoardFits(width int, height int) bool {
+	return width*(height+1) <= 64
+}
+
+func (bitBoard BitBoard) cellBit(column int, row int) uint64 {
+	// rows are numbered from the top like GameBoard, bits are numbered from the bottom
+	return uint64(1) << (column*(bitBoard.height+1) + (bitBoard.height - 1 - row))
+}
+
+func (bitBoard BitBoard) playerIndex(playerValue int) int {
+	for ndx, value := range bitBoard.playerValues {
+		if value == playerValue {
+			return ndx
+		}
+	}
+
+	return NoPlayer
+}
+
+func (bitBoard BitBoard) AvailableRow(column int) int {
+	// Returns a StatusRowIsFull if the column is full
+	if bitBoard.columnHeights[column] >= bitBoard.height {
+		return StatusRowIsFull
+	}
+
+	return bitBoard.height - 1 - bitBoard.columnHeights[column]
+}
+
+func (bitBoard BitBoard) GetHeight() int {
+	return bitBoard.height
+}
+
+func (bitBoard BitBoard) GetSpaceOwnership(column int, row int) int {
+	// returns NoPlayer if owned by neither player
+	cell := bitBoard.cellBit(column, row)
+
+	for ndx, pieces := range bitBoard.pieces {
+		if pieces&cell != 0 {
+			return bitBoard.playerValues[ndx]
+		}
+	}
+
+	return NoPlayer
+}
+
+// GetTurnHistory returns a copy of the turns played, changing it does not change the board
+func (bitBoard BitBoard) GetTurnHistory() []RecordedTurn {
+	return copyTurnHistory(bitBoard.turnHistory)
+}
+
+func (bitBoard BitBoard) GetWidth() int {
+	return bitBoard.width
+}
+
+func (bitBoard BitBoard) GetWinningLength() int {
+	return bitBoard.winningLength
+}
+
+func (bitBoard BitBoard) IsPlayersSpace(player PlayerStrategy, column int, row int) bool {
+	return player.GetPlayerValue() == bitBoard.GetSpaceOwnership(column, row)
+}
+
+func (bitBoard BitBoard) IsVictory() int {
+	for ndx, pieces := range bitBoard.pieces {
+		if bitBoard.hasConnection(pieces) {
+			return bitBoard.playerValues[ndx]
+		}
+	}
+
+	return NoPlayer
+}
+
+// WinningLines lists every run of winningLength or more pieces, with its cells and direction
+func (bitBoard BitBoard) WinningLines() []WinningLine {
+	return findWinningLines(&bitBoard)
+}
+
+func (bitBoard BitBoard) hasConnection(pieces uint64) bool {
+	columnStride := bitBoard.height + 1
+
+	// vertical, horizontal, and the two diagonals
+	for _, shift := range [4]int{1, columnStride, columnStride - 1, columnStride + 1} {
+		run := pieces
+		for step := 1; step < bitBoard.winningLength && run != 0; step++ {
+			run &= pieces >> (step * shift)
+		}
+
+		if run != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bitBoard *BitBoard) PlayPiece(playerValue int, column int) error {
+	// Returns ErrBoardFull, ErrColumnOutOfRange or ErrColumnFull without changing the board if the move is illegal
+	if err := bitBoard.PlayHypothetical(playerValue, column); err != nil {
+		return err
+	}
+
+	bitBoard.hypothetical.commit()
+
+	return nil
+}
+
+// PlayPieceAndCheck is PlayPiece that also reports whether the piece made a connection
+// The bitboard checks every line of the player at once in a few shifts
+func (bitBoard *BitBoard) PlayPieceAndCheck(playerValue int, column int) (bool, error) {
+	if err := bitBoard.PlayPiece(playerValue, column); err != nil {
+		return false, err
+	}
+
+	return bitBoard.hasConnection(bitBoard.pieces[bitBoard.playerIndex(playerValue)]), nil
+}
+
+// PlayHypotheticalAndCheck is PlayHypothetical that also reports whether the piece made a connection
+func (bitBoard *BitBoard) PlayHypotheticalAndCheck(playerValue int, column int) (bool, error) {
+	if err := bitBoard.PlayHypothetical(playerValue, column); err != nil {
+		return false, err
+	}
+
+	return bitBoard.hasConnection(bitBoard.pieces[bitBoard.playerIndex(playerValue)]), nil
+}
+
+// PlayHypothetical plays a piece that Undo can take back
+func (bitBoard *BitBoard) PlayHypothetical(playerValue int, column int) error {
+	playerNdx := bitBoard.playerIndex(playerValue)
+	if playerNdx == NoPlayer {
+		return fmt.Errorf("player value %d is not playing on this board", playerValue)
+	}
+
+	if err := checkMove(bitBoard, column); err != nil {
+		return err
+	}
+
+	row := bitBoard.AvailableRow(column)
+	bitBoard.play(playerNdx, column)
+
+	thisTurn := RecordedTurn{PlayerValue: playerValue, Column: column, Row: row}
+	bitBoard.turnHistory = append(bitBoard.turnHistory, thisTurn)
+	bitBoard.hypothetical.played()
+
+	return nil
+}
+
+// Undo takes back the last hypothetical move, returning ErrNothingToUndo if there is none
+func (bitBoard *BitBoard) Undo() error {
+	if !bitBoard.hypothetical.canUndo() {
+		return ErrNothingToUndo
+	}
+
+	bitBoard.hypothetical.undone(bitBoard.removeLastTurn())
+
+	return nil
+}
+
+// takeBack removes the last turns played for real, when a player's request to undo is granted
+func (bitBoard *BitBoard) takeBack(turns int) error {
+	if turns > len(bitBoard.turnHistory) {
+		return ErrNothingToUndo
+	}
+
+	for range turns {
+		bitBoard.removeLastTurn()
+	}
+	bitBoard.hypothetical.commit()
+
+	return nil
+}
+
+func (bitBoard *BitBoard) removeLastTurn() RecordedTurn {
+	bitBoard.ownHistory()
+	lastTurn := bitBoard.turnHistory[len(bitBoard.turnHistory)-1]
+	bitBoard.undo(bitBoard.playerIndex(lastTurn.PlayerValue), lastTurn.Column)
+	bitBoard.turnHistory = bitBoard.turnHistory[:len(bitBoard.turnHistory)-1]
+
+	return lastTurn
+}
+
+// Redo plays the last undone move again, returning ErrNothingToRedo if there is none
+func (bitBoard *BitBoard) Redo() error {
+	return bitBoard.hypothetical.redo(bitBoard)
+}
+
+func (bitBoard *BitBoard) recordIllegalAttempts(columns []int) {
+	bitBoard.ownHistory()
+	recordIllegalAttempts(bitBoard.turnHistory, columns)
+}
+
+// Clone returns a deep copy that can be played on without changing this board
+func (bitBoard BitBoard) Clone() PlayableGameBoard {
+	return bitBoard.copyBitBoard()
+}
+
+func (bitBoard BitBoard) copyBitBoard() *BitBoard {
+	clone := bitBoard
+	clone.columnHeights = append([]int(nil), bitBoard.columnHeights...)
+	clone.turnHistory = copyTurnHistory(bitBoard.turnHistory)
+	clone.hypothetical = bitBoard.hypothetical.copy()
+	clone.cellsShared = false
+	clone.historyShared = false
+
+	return &clone
+}
+
+// Snapshot returns a read only copy of the board that shares its column heights and turn history
+// The board copies what it shares before it next changes it, like GameBoard.Snapshot.
+func (bitBoard *BitBoard) Snapshot() BoardSnapshot {
+	bitBoard.cellsShared = true
+	bitBoard.historyShared = true
+
+	shared := *bitBoard
+	shared.turnHistory = slices.Clip(bitBoard.turnHistory)
+	shared.hypothetical = bitBoard.hypothetical.copy()
+
+	return BoardSnapshot{board: &shared}
+}
+
+// ownCells copies the column heights before they change if a snapshot shares them
+func (bitBoard *BitBoard) ownCells() {
+	if bitBoard.cellsShared {
+		bitBoard.columnHeights = append([]int(nil), bitBoard.columnHeights...)
+		bitBoard.cellsShared = false
+	}
+}
+
+// ownHistory copies the turn history before a turn in it changes if a snapshot shares it
+func (bitBoard *BitBoard) ownHistory() {
+	if bitBoard.historyShared {
+		bitBoard.turnHistory = slices.Clone(bitBoard.turnHistory)
+		bitBoard.historyShared = false
+	}
+}
+
+// play drops a piece for the player at playerNdx without recording history, the column must not be full
+func (bitBoard *BitBoard) play(playerNdx int, column int) uint64 {
+	bitBoard.ownCells()
+	cell := bitBoard.cellBit(column, bitBoard.height-1-bitBoard.columnHeights[column])
+	bitBoard.pieces[playerNdx] |= cell
+	bitBoard.columnHeights[column]++
+
+	return cell
+}
+
+// undo removes the top piece of the column, which must belong to the player at playerNdx
+func (bitBoard *BitBoard) undo(playerNdx int, column int) uint64 {
+	bitBoard.ownCells()
+	bitBoard.columnHeights[column]--
+	cell := bitBoard.cellBit(column, bitBoard.height-1-bitBoard.columnHeights[column])
+	bitBoard.pieces[playerNdx] &^= cell
+
+	return cell
+}
+
+func (bitBoard BitBoard) legalColumns() []int {
+	columns := make([]int, 0, bitBoard.width)
+	for column, columnHeight := range bitBoard.columnHeights {
+		if columnHeight < bitBoard.height {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+func (bitBoard BitBoard) isFull() bool {
+	for _, columnHeight := range bitBoard.columnHeights {
+		if columnHeight < bitBoard.height {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,227 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func newStandardBitBoard(t testing.TB) *BitBoard {
+	bitBoard, err := NewBitBoard(BoardWidth, BoardHeight, WinningLength, [NumPlayers]int{1, 2})
+	if err != nil {
+		t.Fatalf(`NewBitBoard returned error %v`, err)
+	}
+
+	return bitBoard
+}
+
+func TestBitBoardAvailableRowOnEmptyBoard(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+
+	availableRow := bitBoard.AvailableRow(0)
+
+	if availableRow != BoardHeight-1 {
+		t.Errorf(`TestBitBoardAvailableRowOnEmptyBoard expected to return bottom row but returned %v instead`, availableRow)
+	}
+}
+
+func TestBitBoardAvailableRowOnFullColumn(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+
+	for turn := range BoardHeight {
+		bitBoard.PlayPiece(turn%NumPlayers+1, 0)
+	}
+
+	availableRow := bitBoard.AvailableRow(0)
+
+	if availableRow != StatusRowIsFull {
+		t.Errorf(`TestBitBoardAvailableRowOnFullColumn expected StatusRowIsFull but returned %v instead`, availableRow)
+	}
+}
+
+func TestBitBoardPlayPieceRecordsOwnershipAndHistory(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+
+	bitBoard.PlayPiece(1, 3)
+	bitBoard.PlayPiece(2, 3)
+
+	if bitBoard.GetSpaceOwnership(3, BoardHeight-1) != 1 {
+		t.Errorf(`TestBitBoardPlayPieceRecordsOwnershipAndHistory expected player 1 on the bottom of column 3`)
+	}
+	if bitBoard.GetSpaceOwnership(3, BoardHeight-2) != 2 {
+		t.Errorf(`TestBitBoardPlayPieceRecordsOwnershipAndHistory expected player 2 on top of player 1`)
+	}
+	if bitBoard.GetSpaceOwnership(3, BoardHeight-3) != NoPlayer {
+		t.Errorf(`TestBitBoardPlayPieceRecordsOwnershipAndHistory expected the third space to be empty`)
+	}
+
+	turnHistory := bitBoard.GetTurnHistory()
+	if len(turnHistory) != 2 || !reflect.DeepEqual(turnHistory[1], RecordedTurn{PlayerValue: 2, Column: 3, Row: BoardHeight - 2}) {
+		t.Errorf(`TestBitBoardPlayPieceRecordsOwnershipAndHistory recorded %v`, turnHistory)
+	}
+}
+
+func TestBitBoardPlayPieceRejectsIllegalColumns(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+
+	if err := bitBoard.PlayPiece(1, BoardWidth+1); !errors.Is(err, ErrColumnOutOfRange) {
+		t.Errorf(`TestBitBoardPlayPieceRejectsIllegalColumns expected ErrColumnOutOfRange but got %v`, err)
+	}
+
+	for range BoardHeight {
+		bitBoard.PlayPiece(1, 0)
+	}
+	if err := bitBoard.PlayPiece(2, 0); !errors.Is(err, ErrColumnFull) {
+		t.Errorf(`TestBitBoardPlayPieceRejectsIllegalColumns expected ErrColumnFull but got %v`, err)
+	}
+
+	if len(bitBoard.GetTurnHistory()) != BoardHeight {
+		t.Errorf(`TestBitBoardPlayPieceRejectsIllegalColumns expected illegal moves to leave no history`)
+	}
+}
+
+func TestBitBoardVictoryInEveryDirection(t *testing.T) {
+	testCases := map[string][]int{
+		"vertical":   {0, 1, 0, 1, 0, 1, 0},
+		"horizontal": {0, 0, 1, 1, 2, 2, 3},
+		"up right":   {0, 1, 1, 2, 2, 3, 2, 3, 3, 6, 3},
+		"up left":    {6, 5, 5, 4, 4, 3, 4, 3, 3, 0, 3},
+	}
+
+	for name, columns := range testCases {
+		bitBoard := newStandardBitBoard(t)
+
+		for turn, column := range columns {
+			if winner := bitBoard.IsVictory(); winner != NoPlayer {
+				t.Errorf(`TestBitBoardVictoryInEveryDirection %s declared player %d the winner after %d turns`, name, winner, turn)
+			}
+			bitBoard.PlayPiece(turn%NumPlayers+1, column)
+		}
+
+		if winner := bitBoard.IsVictory(); winner != 1 {
+			t.Errorf(`TestBitBoardVictoryInEveryDirection %s expected player 1 to win but got %d`, name, winner)
+		}
+	}
+}
+
+func TestBitBoardDoesNotWrapBetweenColumns(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+
+	// the top three spaces of column 0 and the bottom space of column 1 are only separated by the empty bit
+	for _, playerValue := range []int{2, 1, 2, 1, 1, 1} {
+		bitBoard.PlayPiece(playerValue, 0)
+	}
+	bitBoard.PlayPiece(1, 1)
+	bitBoard.PlayPiece(1, 1)
+
+	if winner := bitBoard.IsVictory(); winner != NoPlayer {
+		t.Errorf(`TestBitBoardDoesNotWrapBetweenColumns expected no winner but got %d`, winner)
+	}
+}
+
+func TestBitBoardConnectFiveOnLargerBoard(t *testing.T) {
+	bitBoard, err := NewBitBoard(8, 7, 5, [NumPlayers]int{1, 2})
+	if err != nil {
+		t.Fatalf(`TestBitBoardConnectFiveOnLargerBoard returned error %v`, err)
+	}
+
+	for column := range 4 {
+		bitBoard.PlayPiece(1, column)
+	}
+	if winner := bitBoard.IsVictory(); winner != NoPlayer {
+		t.Errorf(`TestBitBoardConnectFiveOnLargerBoard expected no winner with four in a row but got %d`, winner)
+	}
+
+	bitBoard.PlayPiece(1, 7)
+	bitBoard.PlayPiece(1, 4)
+	if winner := bitBoard.IsVictory(); winner != 1 {
+		t.Errorf(`TestBitBoardConnectFiveOnLargerBoard expected player 1 to win but got %d`, winner)
+	}
+}
+
+func TestNewBitBoardRejectsBoardsThatDoNotFit(t *testing.T) {
+	_, err := NewBitBoard(9, 7, WinningLength, [NumPlayers]int{1, 2})
+
+	if err == nil {
+		t.Errorf(`TestNewBitBoardRejectsBoardsThatDoNotFit expected a 9x7 board to be rejected`)
+	}
+}
+
+func TestPlayConnect4OnBitBoard(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "firstavailable"
+	config.BoardImplementation = BoardImplementationBitBoard
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 1 {
+		t.Errorf(`message: %v`, message)
+	}
+}
+
+// randomGameColumns lists the columns of a game of legal random moves that ends when the board fills
+func randomGameColumns(seed int64) []int {
+	rng := rand.New(rand.NewSource(seed))
+	columnHeights := make([]int, BoardWidth)
+	columns := []int{}
+
+	for len(columns) < BoardWidth*BoardHeight {
+		column := rng.Intn(BoardWidth)
+		if columnHeights[column] < BoardHeight {
+			columnHeights[column]++
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+func benchmarkRandomGames(b *testing.B, newBoard func() PlayableGameBoard) {
+	games := make([][]int, 64)
+	for ndx := range games {
+		games[ndx] = randomGameColumns(int64(ndx))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		gameBoard := newBoard()
+		for turn, column := range games[n%len(games)] {
+			gameBoard.PlayPiece(turn%NumPlayers+1, column)
+			if gameBoard.IsVictory() != NoPlayer {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkGameBoardRandomGames(b *testing.B) {
+	benchmarkRandomGames(b, func() PlayableGameBoard {
+		return NewGameBoard()
+	})
+}
+
+func BenchmarkBitBoardRandomGames(b *testing.B) {
+	benchmarkRandomGames(b, func() PlayableGameBoard {
+		return newStandardBitBoard(b)
+	})
+}
+
+func TestBitBoardCloneDoesNotShareState(t *testing.T) {
+	bitBoard := newStandardBitBoard(t)
+	bitBoard.PlayPiece(1, 3)
+
+	clone := bitBoard.Clone()
+	clone.PlayPiece(2, 3)
+
+	if bitBoard.AvailableRow(3) != BoardHeight-2 {
+		t.Errorf(`TestBitBoardCloneDoesNotShareState expected the original column height to be unchanged`)
+	}
+	if len(bitBoard.GetTurnHistory()) != 1 {
+		t.Errorf(`TestBitBoardCloneDoesNotShareState expected the original history to be unchanged but got %v`, bitBoard.GetTurnHistory())
+	}
+	if clone.GetSpaceOwnership(3, BoardHeight-2) != 2 {
+		t.Errorf(`TestBitBoardCloneDoesNotShareState expected the clone to have the new piece`)
+	}
+}
@@ -2,6 +2,8 @@ package game
 
 import (
 	"errors"
+	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -42,7 +44,7 @@ func TestAvailableRowOnFullRow(t *testing.T) {
 		{0, -1, -1, -1, -1, -1, -1},
 		{0, -1, -1, -1, -1, -1, -1},
 		{1, -1, -1, -1, -1, -1, -1},
-		{1, -1, -1, -1, -1, -1, -1},
+		{0, -1, -1, -1, -1, -1, -1},
 	}
 
 	gameBoard := NewInProgressGameBoard(thisBoard)
@@ -167,11 +169,11 @@ func TestIsPlayersSpaceOnPartiallyPlayedBoard(t *testing.T) {
 
 func TestIsHorizontalVictoryBecauseMatchIsOnBottomRow(t *testing.T) {
 	thisBoard := [BoardHeight][BoardWidth]int{
-		{2, -1, -1, -1, -1, -1, -1},
+		{1, -1, -1, -1, -1, -1, -1},
 		{2, -1, -1, -1, -1, -1, -1},
 		{1, -1, -1, 2, -1, -1, -1},
 		{1, 1, -1, 1, -1, -1, -1},
-		{2, 2, 2, 1, 1, -1, -1},
+		{2, 2, 1, 1, 1, -1, -1},
 		{2, 2, 2, 2, 2, 1, -1},
 	}
 
@@ -186,12 +188,12 @@ func TestIsHorizontalVictoryBecauseMatchIsOnBottomRow(t *testing.T) {
 
 func TestIsHorizontalVictoryBecauseMatchIsOn2ndBottomRow(t *testing.T) {
 	thisBoard := [BoardHeight][BoardWidth]int{
-		{2, -1, -1, -1, -1, -1, -1},
+		{1, -1, -1, -1, -1, -1, -1},
 		{2, -1, -1, -1, -1, -1, -1},
 		{1, -1, -1, 2, -1, -1, -1},
 		{1, 1, -1, 1, -1, -1, -1},
 		{2, 2, 2, 2, 1, -1, -1},
-		{2, 2, 2, 1, 2, 1, -1},
+		{2, 1, 2, 1, 2, 1, -1},
 	}
 
 	gameBoard := NewInProgressGameBoard(thisBoard)
@@ -228,8 +230,8 @@ func TestIsDiagonalVictoryBecauseMatchIsDownLeftAndNotOnBottom(t *testing.T) {
 		{-1, -1, 1, 2, -1, -1, -1},
 		{-1, 1, 1, 2, -1, -1, -1},
 		{1, 2, 2, 1, -1, -1, -1},
-		{1, 1, 1, 2, -1, -1, -1},
-		{1, 2, 2, 1, -1, -1, -1},
+		{1, 1, 2, 2, -1, -1, -1},
+		{1, 2, 2, 2, -1, -1, -1},
 	}
 
 	gameBoard := NewInProgressGameBoard(thisBoard)
@@ -248,7 +250,7 @@ func TestIsDiagonalVictoryBecauseMatchIsDownLeftAndOnBottom(t *testing.T) {
 		{-1, -1, -1, -1, -1, -1, 1},
 		{-1, -1, -1, -1, -1, 1, 2},
 		{-1, -1, -1, -1, 1, 2, 1},
-		{-1, -1, -1, 1, 2, 1, 2},
+		{-1, -1, -1, 1, 2, 2, 2},
 	}
 
 	gameBoard := NewInProgressGameBoard(thisBoard)
@@ -267,7 +269,7 @@ func TestIsDiagonalVictoryBecauseMatchIsDownRight(t *testing.T) {
 		{-1, -1, 1, -1, -1, -1, -1},
 		{-1, -1, 2, 1, -1, -1, -1},
 		{-1, -1, 2, 2, 1, -1, -1},
-		{-1, -1, 2, 2, 2, 1, -1},
+		{-1, -1, 1, 2, 2, 1, -1},
 	}
 
 	gameBoard := NewInProgressGameBoard(thisBoard)
@@ -279,6 +281,25 @@ func TestIsDiagonalVictoryBecauseMatchIsDownRight(t *testing.T) {
 	}
 }
 
+func TestIsDiagonalVictoryDownRightAwayFromTheEdges(t *testing.T) {
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 1, -1, -1, -1},
+		{-1, -1, -1, 2, 1, -1, -1},
+		{-1, -1, -1, 2, 2, 1, -1},
+		{-1, -1, -1, 1, 2, 2, 1},
+	}
+
+	gameBoard := NewInProgressGameBoard(thisBoard)
+
+	winner := gameBoard.IsDiagonalVictory()
+
+	if winner != 1 {
+		t.Errorf(`TestIsDiagonalVictoryDownRightAwayFromTheEdges expected player 1 but got %d`, winner)
+	}
+}
+
 func TestIsDiagonalVictoryIsNoPlayer(t *testing.T) {
 	thisBoard := [BoardHeight][BoardWidth]int{
 		{1, -1, -1, -1, -1, -1, -1},
@@ -300,7 +321,7 @@ func TestIsDiagonalVictoryIsNoPlayer(t *testing.T) {
 
 func TestPlayPieceOnFullBoard(t *testing.T) {
 	thisBoard := [BoardHeight][BoardWidth]int{
-		{2, 1, 2, 1, 2, 1, 2},
+		{1, 1, 2, 1, 2, 1, 2},
 		{2, 1, 2, 1, 2, 1, 2},
 		{1, 2, 1, 2, 1, 2, 1},
 		{1, 2, 1, 2, 1, 2, 1},
@@ -314,9 +335,8 @@ func TestPlayPieceOnFullBoard(t *testing.T) {
 	if err == nil {
 		t.Errorf(`TestPlayPieceOnFullBoard expected to error but did not`)
 	}
-	errorNoAvailableMove := errors.New("no available move")
-	if errors.Is(err, errorNoAvailableMove) {
-		t.Errorf(`TestPlayPieceOnFullBoard expected error message of %v but got %v`, errorNoAvailableMove, err)
+	if !errors.Is(err, ErrBoardFull) {
+		t.Errorf(`TestPlayPieceOnFullBoard expected error message of %v but got %v`, ErrBoardFull, err)
 	}
 }
 
@@ -345,21 +365,148 @@ func TestPlayPieceOnEmptyBoard(t *testing.T) {
 func TestPlayPieceOutOfBounds(t *testing.T) {
 	gameBoard := NewGameBoard()
 
-	err := gameBoard.PlayPiece(1, BoardWidth+1)
+	for _, column := range []int{BoardWidth + 1, BoardWidth, -1, StatusNoAvailableMove} {
+		err := gameBoard.PlayPiece(1, column)
+		if !errors.Is(err, ErrColumnOutOfRange) {
+			t.Errorf(`TestPlayPieceOutOfBounds expected column %d to return %v but got %v`, column, ErrColumnOutOfRange, err)
+		}
+	}
+
+	if !reflect.DeepEqual(gameBoard.board, NewGameBoard().board) {
+		t.Errorf(`TestPlayPieceOutOfBounds expected the board to be unchanged`)
+	}
+	if len(gameBoard.GetTurnHistory()) != 0 {
+		t.Errorf(`TestPlayPieceOutOfBounds expected no turns to be recorded`)
+	}
+}
+
+func TestPlayPieceInFirstColumn(t *testing.T) {
+	gameBoard := NewGameBoard()
+
+	err := gameBoard.PlayPiece(1, 0)
 	if err != nil {
-		t.Errorf(`TestPlayPieceOnEmptyBoard returned error %v`, err)
+		t.Errorf(`TestPlayPieceInFirstColumn returned error %v`, err)
 	}
 
-	transposedExpectedBoard := [BoardHeight][BoardWidth]int{
-		{-1, -1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, -1, -1, -1, -1},
-		{-1, -1, -1, 1, -1, -1, -1},
+	if gameBoard.GetSpaceOwnership(0, BoardHeight-1) != 1 {
+		t.Errorf(`TestPlayPieceInFirstColumn expected the piece to land in column 0`)
 	}
-	expectedBoard := TransposeMatrix(transposedExpectedBoard)
-	if !reflect.DeepEqual(gameBoard.board, expectedBoard) {
-		t.Errorf(`TestPlayPieceOnEmptyBoard expected to alter the board differently`)
+}
+
+func TestPlayPieceOnFullColumn(t *testing.T) {
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{1, -1, -1, -1, -1, -1, -1},
+		{2, -1, -1, -1, -1, -1, -1},
+		{1, -1, -1, -1, -1, -1, -1},
+		{2, -1, -1, -1, -1, -1, -1},
+		{1, -1, -1, -1, -1, -1, -1},
+		{2, -1, -1, -1, -1, -1, -1},
+	}
+
+	gameBoard := NewInProgressGameBoard(thisBoard)
+
+	err := gameBoard.PlayPiece(2, 0)
+	if !errors.Is(err, ErrColumnFull) {
+		t.Errorf(`TestPlayPieceOnFullColumn expected %v but got %v`, ErrColumnFull, err)
+	}
+}
+
+func TestNewGameBoardOfSizeIsEmpty(t *testing.T) {
+	gameBoard := NewGameBoardOfSize(9, 7, 5)
+
+	if gameBoard.GetWidth() != 9 || gameBoard.GetHeight() != 7 || gameBoard.GetWinningLength() != 5 {
+		t.Errorf(`TestNewGameBoardOfSizeIsEmpty expected a 9x7 connect 5 board but got %dx%d connect %d`, gameBoard.GetWidth(), gameBoard.GetHeight(), gameBoard.GetWinningLength())
+	}
+
+	for column := range 9 {
+		if gameBoard.AvailableRow(column) != 6 {
+			t.Errorf(`TestNewGameBoardOfSizeIsEmpty expected column %d to have bottom row 6 available but got %d`, column, gameBoard.AvailableRow(column))
+		}
+	}
+}
+
+func TestPlayPieceOnWidestColumnOfLargerBoard(t *testing.T) {
+	gameBoard := NewGameBoardOfSize(8, 7, WinningLength)
+
+	err := gameBoard.PlayPiece(1, 7)
+	if err != nil {
+		t.Errorf(`TestPlayPieceOnWidestColumnOfLargerBoard returned error %v`, err)
+	}
+
+	if gameBoard.GetSpaceOwnership(7, 6) != 1 {
+		t.Errorf(`TestPlayPieceOnWidestColumnOfLargerBoard expected column 7 row 6 to be owned by player 1`)
+	}
+}
+
+func TestIsHorizontalVictoryNeedsFiveWhenConnectFive(t *testing.T) {
+	rows := [][]int{
+		{-1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1},
+		{2, 2, 2, -1, -1, -1},
+		{1, 1, 1, 1, -1, -1},
+	}
+
+	gameBoard := NewInProgressGameBoardOfSize(rows, 5)
+	if winner := gameBoard.IsVictory(); winner != NoPlayer {
+		t.Errorf(`TestIsHorizontalVictoryNeedsFiveWhenConnectFive expected no winner with four in a row but got %d`, winner)
+	}
+
+	gameBoard.PlayPiece(1, 4)
+	if winner := gameBoard.IsVictory(); winner != 1 {
+		t.Errorf(`TestIsHorizontalVictoryNeedsFiveWhenConnectFive expected player 1 to win with five in a row but got %d`, winner)
+	}
+}
+
+func TestIsVerticalVictoryOnTallerBoard(t *testing.T) {
+	gameBoard := NewGameBoardOfSize(6, 9, WinningLength)
+
+	for range WinningLength {
+		gameBoard.PlayPiece(2, 5)
+	}
+
+	if winner := gameBoard.IsVictory(); winner != 2 {
+		t.Errorf(`TestIsVerticalVictoryOnTallerBoard expected player 2 to win but got %d`, winner)
+	}
+}
+
+func TestPlayPieceAndCheckAgreesWithWinningLines(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sizes := [][3]int{{7, 6, 4}, {4, 4, 4}, {9, 7, 5}, {5, 8, 3}, {3, 3, 4}, {1, 6, 4}}
+
+	for game := range 400 {
+		size := sizes[game%len(sizes)]
+		boards := map[string]PlayableGameBoard{BoardImplementationArray: NewGameBoardOfSize(size[0], size[1], size[2])}
+		if bitBoard, err := NewBitBoard(size[0], size[1], size[2], [NumPlayers]int{1, 2}); err == nil {
+			boards[BoardImplementationBitBoard] = bitBoard
+		}
+
+		for name, gameBoard := range boards {
+			moves := ""
+			for turn := 0; !isBoardFull(gameBoard); turn++ {
+				playerValue := turn%NumPlayers + 1
+				column := legalColumns(gameBoard)[rng.Intn(len(legalColumns(gameBoard)))]
+				moves += fmt.Sprint(column + 1)
+
+				check := gameBoard.PlayPieceAndCheck
+				if turn%2 == 1 {
+					check = gameBoard.PlayHypotheticalAndCheck
+				}
+				won, err := check(playerValue, column)
+				if err != nil {
+					t.Fatalf(`TestPlayPieceAndCheckAgreesWithWinningLines %s returned error %v`, name, err)
+				}
+
+				if won != (gameBoard.IsVictory() == playerValue) {
+					t.Fatalf(`TestPlayPieceAndCheckAgreesWithWinningLines %s %dx%d connect %d reported %v after moves %s but IsVictory is %d`, name, size[0], size[1], size[2], won, moves, gameBoard.IsVictory())
+				}
+				if lines := gameBoard.WinningLines(); won != (len(lines) > 0) {
+					t.Fatalf(`TestPlayPieceAndCheckAgreesWithWinningLines %s %dx%d connect %d reported %v after moves %s but the winning lines are %v`, name, size[0], size[1], size[2], won, moves, lines)
+				}
+				if won {
+					break
+				}
+			}
+		}
 	}
 }
@@ -0,0 +1,121 @@
+package game
+
+// BoardView is the read only view of the board a strategy is given to choose its move
+//
+// The view answers the questions a strategy asks about the position but has no way to change the game.
+// To look ahead, take a Clone and play on that.
+type BoardView struct {
+	board         GameBoardActions
+	playerValue   int
+	opponentValue int
+}
+
+// boardReader is the part of a board that both GameBoardActions and BoardView can answer
+type boardReader interface {
+	AvailableRow(column int) int
+	GetHeight() int
+	GetSpaceOwnership(column int, row int) int
+	GetWidth() int
+	GetWinningLength() int
+	WinningLines() []WinningLine
+}
+
+// NewBoardView shows gameBoard to the player whose pieces are playerValue, playing against opponentValue
+func NewBoardView(gameBoard GameBoardActions, playerValue int, opponentValue int) BoardView {
+	return BoardView{board: gameBoard, playerValue: playerValue, opponentValue: opponentValue}
+}
+
+// AvailableRow returns the row the next piece in the column lands in, or StatusRowIsFull
+func (view BoardView) AvailableRow(column int) int {
+	return view.board.AvailableRow(column)
+}
+
+func (view BoardView) GetHeight() int {
+	return view.board.GetHeight()
+}
+
+func (view BoardView) GetSpaceOwnership(column int, row int) int {
+	return view.board.GetSpaceOwnership(column, row)
+}
+
+func (view BoardView) GetWidth() int {
+	return view.board.GetWidth()
+}
+
+func (view BoardView) GetWinningLength() int {
+	return view.board.GetWinningLength()
+}
+
+// GetTurnHistory returns the copy of the turns played that the board returns, changing it does not change the game
+func (view BoardView) GetTurnHistory() []RecordedTurn {
+	return view.board.GetTurnHistory()
+}
+
+func (view BoardView) IsPlayersSpace(player PlayerStrategy, column int, row int) bool {
+	return view.board.IsPlayersSpace(player, column, row)
+}
+
+func (view BoardView) IsVictory() int {
+	return view.board.IsVictory()
+}
+
+// WinningLines lists every connection on the board with its cells and direction
+func (view BoardView) WinningLines() []WinningLine {
+	return view.board.WinningLines()
+}
+
+// PlayerValue is the value of the pieces of the player choosing the move
+func (view BoardView) PlayerValue() int {
+	return view.playerValue
+}
+
+// OpponentValue is the value of the other player's pieces
+func (view BoardView) OpponentValue() int {
+	return view.opponentValue
+}
+
+// ColumnHeight counts the pieces in the column
+func (view BoardView) ColumnHeight(column int) int {
+	availableRow := view.board.AvailableRow(column)
+	if availableRow == StatusRowIsFull {
+		return view.board.GetHeight()
+	}
+
+	return view.board.GetHeight() - 1 - availableRow
+}
+
+// LegalColumns lists the columns that are not full, from left to right
+func (view BoardView) LegalColumns() []int {
+	return legalColumns(view.board)
+}
+
+// MoveNumber is the number of the move being chosen, 1 for the first move of the game
+func (view BoardView) MoveNumber() int {
+	pieces := 0
+	for column := range view.board.GetWidth() {
+		pieces += view.ColumnHeight(column)
+	}
+
+	return pieces + 1
+}
+
+// Clone returns a copy of the board that the strategy can play on
+func (view BoardView) Clone() PlayableGameBoard {
+	return view.board.Clone()
+}
+
+func legalColumns(gameBoard boardReader) []int {
+	columns := []int{}
+	for column := range gameBoard.GetWidth() {
+		if gameBoard.AvailableRow(column) != StatusRowIsFull {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+// Snapshot returns the board the view shows, it never changes
+func (view BoardView) Snapshot() BoardSnapshot {
+	return view.board.Snapshot()
+}
@@ -0,0 +1,82 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newTestBoardView shows gameBoard to playerValue, whose opponent owns any other piece on the board
+func newTestBoardView(gameBoard GameBoardActions, playerValue int) BoardView {
+	opponentValue := playerValue + 1
+	if opponentValue == NoPlayer {
+		opponentValue++
+	}
+
+	for column := range gameBoard.GetWidth() {
+		for row := range gameBoard.GetHeight() {
+			if owner := gameBoard.GetSpaceOwnership(column, row); owner != NoPlayer && owner != playerValue {
+				opponentValue = owner
+			}
+		}
+	}
+
+	return NewBoardView(gameBoard, playerValue, opponentValue)
+}
+
+func TestBoardViewQueries(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("4445")
+	if err != nil {
+		t.Fatalf(`TestBoardViewQueries returned error %v`, err)
+	}
+	view := NewBoardView(gameBoard, 1, 2)
+
+	if view.PlayerValue() != 1 || view.OpponentValue() != 2 {
+		t.Errorf(`TestBoardViewQueries expected player 1 against player 2 but got %d against %d`, view.PlayerValue(), view.OpponentValue())
+	}
+	if view.MoveNumber() != 5 {
+		t.Errorf(`TestBoardViewQueries expected move 5 but got %d`, view.MoveNumber())
+	}
+	if view.ColumnHeight(3) != 3 || view.ColumnHeight(4) != 1 || view.ColumnHeight(0) != 0 {
+		t.Errorf(`TestBoardViewQueries expected column heights 3, 1 and 0 but got %d, %d and %d`, view.ColumnHeight(3), view.ColumnHeight(4), view.ColumnHeight(0))
+	}
+	if view.GetSpaceOwnership(3, BoardHeight-2) != 2 {
+		t.Errorf(`TestBoardViewQueries expected player 2 to own [3][%d]`, BoardHeight-2)
+	}
+	if !reflect.DeepEqual(view.LegalColumns(), []int{0, 1, 2, 3, 4, 5, 6}) {
+		t.Errorf(`TestBoardViewQueries expected every column to be legal but got %v`, view.LegalColumns())
+	}
+}
+
+func TestBoardViewLegalColumnsSkipsFullColumns(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("444444")
+	if err != nil {
+		t.Fatalf(`TestBoardViewLegalColumnsSkipsFullColumns returned error %v`, err)
+	}
+	view := NewBoardView(gameBoard, 1, 2)
+
+	if !reflect.DeepEqual(view.LegalColumns(), []int{0, 1, 2, 4, 5, 6}) {
+		t.Errorf(`TestBoardViewLegalColumnsSkipsFullColumns expected column 3 to be full but got %v`, view.LegalColumns())
+	}
+	if view.ColumnHeight(3) != BoardHeight {
+		t.Errorf(`TestBoardViewLegalColumnsSkipsFullColumns expected a full column height of %d but got %d`, BoardHeight, view.ColumnHeight(3))
+	}
+}
+
+func TestBoardViewHistoryIsACopy(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("44")
+	if err != nil {
+		t.Fatalf(`TestBoardViewHistoryIsACopy returned error %v`, err)
+	}
+	view := NewBoardView(gameBoard, 1, 2)
+
+	view.GetTurnHistory()[0].Column = 99
+	clone := view.Clone()
+	clone.PlayPiece(1, 0)
+
+	if gameBoard.GetTurnHistory()[0].Column != 3 || len(gameBoard.GetTurnHistory()) != 2 {
+		t.Errorf(`TestBoardViewHistoryIsACopy expected the history to be unchanged but got %v`, gameBoard.GetTurnHistory())
+	}
+	if gameBoard.GetSpaceOwnership(0, BoardHeight-1) != NoPlayer {
+		t.Errorf(`TestBoardViewHistoryIsACopy expected the board to be unchanged by the clone`)
+	}
+}
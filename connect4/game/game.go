@@ -3,6 +3,10 @@ package game
 import (
 	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"time"
 )
 
 const WinningLength int = 4
@@ -12,11 +16,33 @@ const NumPlayers int = 2
 const NoPlayer int = -1
 const StatusRowIsFull int = -1
 const StatusNoAvailableMove int = -2
+const StatusResign int = -3      // an interactive strategy returns StatusResign to give up the game
+const StatusUndoRequest int = -4 // an interactive strategy returns StatusUndoRequest to take back its last move and the reply to it
+const MaxTakeBacks int = 3       // how many times each interactive player may take back a move in a game
+
+const BoardImplementationArray string = "array"
+const BoardImplementationBitBoard string = "bitboard"
 
 type GameConfig struct {
 	Player1                string
 	Player2                string
 	ModuloToPrintGameBoard int
+	BoardWidth             int
+	BoardHeight            int
+	WinningLength          int
+	BoardImplementation    string
+	MoveTimeBudget         time.Duration // the longest a player may think about one move, zero means no limit
+	TotalClock             time.Duration // each player's time for the whole game, zero means no clock
+	ClockIncrement         time.Duration // added to a player's clock after each of their moves
+	TimeoutPolicy          string        // what happens when a player runs out of time
+	IllegalMovePolicy      string        // what happens when a player chooses a column that cannot be played
+	IllegalMoveRetries     int           // how many more times the retry policy asks the player to choose
+	Seed                   int64         // seeds every random choice in the game, zero means a random seed recorded in the GameResult
+	Output                 io.Writer     // where the game is printed, nil prints nothing
+	Renderer               Renderer      // draws the boards printed to Output, nil draws them with the ASCIIRenderer
+	Debug                  bool          // check the board is a valid position after every move
+	TerminalUI             *TerminalUI   // draws the game full screen in place of the printed boards, nil prints them
+	HumanHint              string        // the strategy a human player asks for a hint, empty means DefaultHumanHintStrategy
 }
 
 func NewDefaultGameConfig() GameConfig {
@@ -24,55 +50,252 @@ func NewDefaultGameConfig() GameConfig {
 		Player1:                "random",
 		Player2:                "random",
 		ModuloToPrintGameBoard: 5,
+		BoardWidth:             BoardWidth,
+		BoardHeight:            BoardHeight,
+		WinningLength:          WinningLength,
+		BoardImplementation:    BoardImplementationArray,
+		TimeoutPolicy:          TimeoutPolicyFirstAvailable,
+		IllegalMovePolicy:      IllegalMovePolicySubstitute,
+		IllegalMoveRetries:     2,
+		Output:                 os.Stdout,
+		Renderer:               ASCIIRenderer{},
+		HumanHint:              DefaultHumanHintStrategy,
+	}
+}
+
+// ValidateBoardSize reports board dimensions that NewGameBoardOfSize cannot build
+func (config GameConfig) ValidateBoardSize() error {
+	if config.BoardWidth < 1 || config.BoardHeight < 1 {
+		return fmt.Errorf("board must be at least 1x1, got %dx%d", config.BoardWidth, config.BoardHeight)
+	}
+
+	if config.WinningLength < 1 || config.WinningLength > max(config.BoardWidth, config.BoardHeight) {
+		return fmt.Errorf("winning length %d does not fit on a %dx%d board", config.WinningLength, config.BoardWidth, config.BoardHeight)
+	}
+
+	if config.BoardImplementation == BoardImplementationBitBoard && !BitBoardFits(config.BoardWidth, config.BoardHeight) {
+		return fmt.Errorf("a %dx%d board is too large for the %s implementation", config.BoardWidth, config.BoardHeight, BoardImplementationBitBoard)
 	}
+
+	return nil
 }
 
-func PlayConnect4(config GameConfig) (int, string) {
-	errorNoAvailableMove := errors.New("no available move")
+// NewGameBoardForConfig builds the board implementation selected by config.BoardImplementation
+func NewGameBoardForConfig(config GameConfig, playerValues [NumPlayers]int) (PlayableGameBoard, error) {
+	switch config.BoardImplementation {
+	case BoardImplementationArray, "":
+		return NewGameBoardOfSize(config.BoardWidth, config.BoardHeight, config.WinningLength), nil
+	case BoardImplementationBitBoard:
+		return NewBitBoard(config.BoardWidth, config.BoardHeight, config.WinningLength, playerValues)
+	default:
+		return nil, fmt.Errorf("unknown board implementation %q", config.BoardImplementation)
+	}
+}
 
-	gameBoard := NewGameBoard()
+// PlayConnect4 plays one game between the strategies in config and reports how it went
+func PlayConnect4(config GameConfig) GameResult {
 	playerValues := [NumPlayers]int{1, 2}
+	result := GameResult{
+		Winner:        NoPlayer,
+		PlayerValues:  playerValues,
+		BoardWidth:    config.BoardWidth,
+		BoardHeight:   config.BoardHeight,
+		WinningLength: config.WinningLength,
+		EndedBy:       NoPlayer,
+		StartedAt:     time.Now(),
+	}
+
+	notStarted := func(err error) GameResult {
+		result.EndReason = GameEndNotStarted
+		result.Err = err
+		result.EndedAt = time.Now()
+		return result
+	}
+
+	if err := config.ValidateTimeControl(); err != nil {
+		return notStarted(err)
+	}
+
+	if err := config.ValidateIllegalMovePolicy(); err != nil {
+		return notStarted(err)
+	}
+
+	if err := config.ValidateHumanHint(); err != nil {
+		return notStarted(err)
+	}
+
+	playableBoard, err := NewGameBoardForConfig(config, playerValues)
+	if err != nil {
+		return notStarted(err)
+	}
+
+	gameBoard, isEngineBoard := playableBoard.(engineBoard)
+	if !isEngineBoard {
+		return notStarted(fmt.Errorf("the %s board cannot take back moves", config.BoardImplementation))
+	}
+
+	output := config.Output
+	if output == nil {
+		output = io.Discard
+	}
+
+	renderer := config.Renderer
+	if renderer == nil {
+		renderer = ASCIIRenderer{}
+	}
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = rand.Int63()
+	}
+	result.Seed = seed
+	gameRng := rand.New(rand.NewSource(seed))
 
-	player1 := CreatePlayerStrategy(config.Player1, playerValues[0])
-	player2 := CreatePlayerStrategy(config.Player2, playerValues[1])
-	players := [NumPlayers]PlayerStrategy{player1, player2}
+	runners := [NumPlayers]*strategyRunner{}
+	for playerNdx := range NumPlayers {
+		strategyRng := rand.New(rand.NewSource(gameRng.Int63()))
+		substituteRng := rand.New(rand.NewSource(gameRng.Int63()))
+		playerOption := config.Player1
+		if playerNdx == 1 {
+			playerOption = config.Player2
+		}
+
+		player := GetRegisteredSeededPlayerStrategy(playerOption, playerValues[playerNdx], strategyRng)
+		if player == nil {
+			playerOption = "random"
+			player = GetRegisteredSeededPlayerStrategy(playerOption, playerValues[playerNdx], strategyRng)
+		}
+
+		if configured, isConfigured := player.(gameConfigured); isConfigured {
+			configured.useGameConfig(config)
+		}
+
+		runners[playerNdx] = &strategyRunner{player: player, opponentValue: playerValues[(playerNdx+1)%NumPlayers], rng: substituteRng, interactive: isInteractive(playerOption)}
+		result.PlayerNames[playerNdx] = player.GetName()
+		result.Strategies[playerNdx] = playerOption
+	}
+
+	fmt.Fprintln(output, `Player1: `, result.PlayerNames[0], ` and Player2: `, result.PlayerNames[1])
+	fmt.Fprintln(output, `Seed: `, seed)
 
-	fmt.Println(`Player1: `, player1.GetName(), ` and Player2: `, player2.GetName())
+	clocks := [NumPlayers]playerClock{{remaining: config.TotalClock}, {remaining: config.TotalClock}}
 
-	winner := NoPlayer
+	if config.TerminalUI != nil {
+		config.TerminalUI.startGame(playerValues, result.PlayerNames)
+		config.TerminalUI.setClocks(config, clocks, result.ThinkTimes)
+		config.TerminalUI.showBoard(gameBoard, NoPlayer, "")
+	}
+
+	result.EndReason = GameEndDraw
 
 	turn := 0
-	for turn = range BoardWidth * BoardHeight {
+	for turn = 0; turn < config.BoardWidth*config.BoardHeight; turn++ {
 		whosTurn := turn % NumPlayers
-		columnChosen := players[whosTurn].PlayerChoosesAMove(gameBoard)
+		opponent := playerValues[(whosTurn+1)%NumPlayers]
+		thinkStart := time.Now()
+
+		columnChosen, timedOut, err := runners[whosTurn].chooseMoveFor(config, &clocks[whosTurn], gameBoard)
+
+		// only a person may take back a move or resign, any other negative column is an illegal move
+		if err == nil && !timedOut && runners[whosTurn].interactive && columnChosen == StatusUndoRequest {
+			if runners[whosTurn].takeBacks >= MaxTakeBacks {
+				fmt.Fprintln(output, `Player `, playerValues[whosTurn], ` has no take-backs left`)
+				turn--
+				continue
+			}
 
-		err := gameBoard.PlayPiece(playerValues[whosTurn], columnChosen)
+			// a granted request takes back the player's last move and the reply, then asks the player again
+			if gameBoard.takeBack(NumPlayers) == nil {
+				runners[whosTurn].takeBacks++
+				result.ThinkTimes = result.ThinkTimes[:len(result.ThinkTimes)-NumPlayers]
+				fmt.Fprintln(output, `Player `, playerValues[whosTurn], ` takes back their last move`)
+				if config.TerminalUI != nil {
+					config.TerminalUI.showBoard(gameBoard, NoPlayer, "")
+				}
+				turn -= NumPlayers + 1
+				continue
+			}
+		}
+
+		if err == nil && !timedOut && runners[whosTurn].interactive && columnChosen == StatusResign {
+			err = errResigned
+		}
 
-		if errors.Is(err, errorNoAvailableMove) {
-			winner = NoPlayer
+		if timedOut && err == nil {
+			if config.TimeoutPolicy == TimeoutPolicyForfeit {
+				err = errOutOfTime
+			} else {
+				columnChosen = timeoutSubstitute(config.TimeoutPolicy, playerValues[whosTurn], gameBoard, runners[whosTurn].rng)
+			}
+		}
+
+		won := false
+		if err == nil {
+			won, err = playChosenColumn(config, runners[whosTurn], &clocks[whosTurn], gameBoard, playerValues[whosTurn], columnChosen)
+			clocks[whosTurn].remaining += config.ClockIncrement
+		}
+
+		if errors.Is(err, ErrBoardFull) {
 			break
 		}
 
-		winner = gameBoard.IsVictory()
-		if winner != NoPlayer {
+		if err != nil {
+			result.Winner = opponent
+			result.EndedBy = playerValues[whosTurn]
+			result.Err = err
+
+			switch {
+			case errors.Is(err, errResigned):
+				result.EndReason = GameEndResign
+			case errors.As(err, &result.Panic):
+				result.EndReason = GameEndForfeit
+			case errors.Is(err, errOutOfTime):
+				result.EndReason = GameEndTimeout
+			default:
+				result.EndReason = GameEndIllegalMove
+			}
 			break
 		}
 
-		if turn%config.ModuloToPrintGameBoard == 0 {
-			gameBoard.PrintGameBoard(turn)
+		result.ThinkTimes = append(result.ThinkTimes, time.Since(thinkStart))
+
+		if config.TerminalUI != nil {
+			config.TerminalUI.setClocks(config, clocks, result.ThinkTimes)
+			config.TerminalUI.showMove(gameBoard)
 		}
-	}
 
-	gameBoard.PrintGameBoard(turn)
+		if config.Debug {
+			if err := validatePosition(gameBoard, playerValues[0]); err != nil {
+				result.EndReason = GameEndInvalidPosition
+				result.Err = err
+				break
+			}
+		}
 
-	winningPlayer := GetPlayerStrategyByValue(players, winner)
+		if won {
+			result.Winner = playerValues[whosTurn]
+			result.EndReason = GameEndConnect
+			result.WinningLines = gameBoard.WinningLines()
+			break
+		}
+
+		// a cadence of 0 only prints the final board
+		if config.TerminalUI == nil && config.ModuloToPrintGameBoard > 0 && turn%config.ModuloToPrintGameBoard == 0 {
+			renderer.Render(output, gameBoard.Snapshot())
+		}
+	}
 
-	if winningPlayer == nil {
-		return NoPlayer, fmt.Sprintf(`Turn %d the Winner is Neither Player. The Match has ended in a tie`, turn)
+	// a game that fills the board ends on its last turn
+	turn = min(turn, config.BoardWidth*config.BoardHeight-1)
+	if config.TerminalUI == nil {
+		renderer.Render(output, gameBoard.Snapshot())
 	}
 
-	message := fmt.Sprintf(`Turn %d the Winner is Player %d %v`, turn, winner, winningPlayer.GetName())
-	return winner, message
+	result.Turn = turn
+	result.Turns = gameBoard.GetTurnHistory()
+	result.EndedAt = time.Now()
+
+	return result
 }
 
 func GetPlayerStrategyByValue(players [NumPlayers]PlayerStrategy, playerValue int) PlayerStrategy {
@@ -86,10 +309,15 @@ func GetPlayerStrategyByValue(players [NumPlayers]PlayerStrategy, playerValue in
 }
 
 func CreatePlayerStrategy(option string, playerValue int) PlayerStrategy {
-	player := GetRegisteredPlayerStrategy(option, playerValue)
+	return CreateSeededPlayerStrategy(option, playerValue, newRandomlySeededRand())
+}
+
+// CreateSeededPlayerStrategy is CreatePlayerStrategy for a strategy that draws its random numbers from rng
+func CreateSeededPlayerStrategy(option string, playerValue int, rng *rand.Rand) PlayerStrategy {
+	player := GetRegisteredSeededPlayerStrategy(option, playerValue, rng)
 
 	if player == nil {
-		player = GetRegisteredPlayerStrategy("random", playerValue)
+		player = GetRegisteredSeededPlayerStrategy("random", playerValue, rng)
 	}
 
 	return player
@@ -8,7 +8,8 @@ func TestPlayConnect4(t *testing.T) {
 	config := NewDefaultGameConfig()
 	config.Player1 = "firstavailable"
 	config.Player2 = "firstavailable"
-	winner, message := PlayConnect4(config)
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
 
 	if winner != 1 {
 		t.Errorf(`message: %v`, message)
@@ -68,3 +69,26 @@ func TestGetPlayerStrategyWithNotFoundOption(t *testing.T) {
 		t.Errorf(`TestGetPlayerStrategyWithNotFoundOption expected to create a playerValue of 2, but created %d instead`, playerStrategy.GetPlayerValue())
 	}
 }
+
+func TestPlayConnect4OnLargerBoard(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "firstavailable"
+	config.BoardWidth = 8
+	config.BoardHeight = 7
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 1 {
+		t.Errorf(`message: %v`, message)
+	}
+}
+
+func TestValidateBoardSizeRejectsUnwinnableLength(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.WinningLength = 8
+
+	if err := config.ValidateBoardSize(); err == nil {
+		t.Errorf(`TestValidateBoardSizeRejectsUnwinnableLength expected connect 8 on a 7x6 board to be rejected`)
+	}
+}
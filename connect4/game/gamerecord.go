@@ -0,0 +1,359 @@
+package game
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Game record results, from player 1's point of view like the results of a chess PGN
+const (
+	RecordResultPlayer1Wins string = "1-0"
+	RecordResultPlayer2Wins string = "0-1"
+	RecordResultDraw        string = "1/2-1/2"
+	RecordResultUnfinished  string = "*"
+)
+
+// recordDateLayout is the PGN date format, an unknown date is written as ????.??.??
+const recordDateLayout string = "2006.01.02"
+const recordUnknownDate string = "????.??.??"
+
+// GameRecord is one game in the portable text format
+//
+// A record is a block of header tags followed by the moves, with a blank line between records
+//
+//	[Player1 "negamax"]
+//	[Player2 "blocker"]
+//	[Date "2026.10.18"]
+//	[Seed "12345"]
+//	[Width "7"]
+//	[Height "6"]
+//	[Connect "4"]
+//	[Result "1-0"]
+//	[Termination "connect"]
+//
+//	4453423
+//
+// Moves are columns numbered from 1 at the left. On boards wider than 9 columns the moves are separated by spaces.
+// Player 1 always moves first.
+type GameRecord struct {
+	Player1       string
+	Player2       string
+	Date          time.Time // the zero time when the date is unknown
+	Seed          int64
+	Width         int
+	Height        int
+	WinningLength int
+	Result        string        // one of the RecordResult constants
+	Termination   GameEndReason // empty when it is not known
+	Moves         []int         // the columns played, counting from 0
+}
+
+// NewGameRecord records a game played by PlayConnect4
+func NewGameRecord(result GameResult) GameRecord {
+	record := GameRecord{
+		Player1:       result.Strategies[0],
+		Player2:       result.Strategies[1],
+		Date:          result.StartedAt,
+		Seed:          result.Seed,
+		Width:         result.BoardWidth,
+		Height:        result.BoardHeight,
+		WinningLength: result.WinningLength,
+		Result:        RecordResultDraw,
+		Termination:   result.EndReason,
+		Moves:         turnColumns(result.Turns),
+	}
+
+	switch result.Winner {
+	case result.PlayerValues[0]:
+		record.Result = RecordResultPlayer1Wins
+	case result.PlayerValues[1]:
+		record.Result = RecordResultPlayer2Wins
+	}
+	if result.EndReason == GameEndNotStarted || result.EndReason == GameEndInvalidPosition {
+		record.Result = RecordResultUnfinished
+	}
+
+	return record
+}
+
+// NewGameRecordOfBoard records the moves on a board, the result is worked out from the board
+func NewGameRecordOfBoard(gameBoard GameBoardActions) GameRecord {
+	turnHistory := gameBoard.GetTurnHistory()
+	record := GameRecord{
+		Width:         gameBoard.GetWidth(),
+		Height:        gameBoard.GetHeight(),
+		WinningLength: gameBoard.GetWinningLength(),
+		Result:        RecordResultUnfinished,
+		Moves:         turnColumns(turnHistory),
+	}
+
+	winner := gameBoard.IsVictory()
+	switch {
+	case winner != NoPlayer && len(turnHistory) > 0 && winner == turnHistory[0].PlayerValue:
+		record.Result = RecordResultPlayer1Wins
+		record.Termination = GameEndConnect
+	case winner != NoPlayer:
+		record.Result = RecordResultPlayer2Wins
+		record.Termination = GameEndConnect
+	case isBoardFull(gameBoard):
+		record.Result = RecordResultDraw
+		record.Termination = GameEndDraw
+	}
+
+	return record
+}
+
+func turnColumns(turnHistory []RecordedTurn) []int {
+	columns := make([]int, len(turnHistory))
+	for ndx, turn := range turnHistory {
+		columns[ndx] = turn.Column
+	}
+
+	return columns
+}
+
+// FormatMoves writes columns counting from 0 in the move notation of a game record
+func FormatMoves(columns []int, width int) string {
+	moves := make([]string, len(columns))
+	for ndx, column := range columns {
+		moves[ndx] = strconv.Itoa(column + 1)
+	}
+
+	if width > 9 {
+		return strings.Join(moves, " ")
+	}
+
+	return strings.Join(moves, "")
+}
+
+// ParseMoves reads the move notation of a game record and returns the columns counting from 0
+// On boards up to 9 columns wide every move is one digit, wider boards separate their moves with spaces.
+func ParseMoves(moves string, width int) ([]int, error) {
+	tokens := strings.Fields(moves)
+	if width <= 9 {
+		tokens = strings.Split(strings.Join(tokens, ""), "")
+	}
+
+	columns := make([]int, 0, len(tokens))
+	for ndx, token := range tokens {
+		column, err := strconv.Atoi(token)
+		if err != nil || column < 1 || column > width {
+			return nil, fmt.Errorf("move %d %q is not a column from 1 to %d", ndx+1, token, width)
+		}
+		columns = append(columns, column-1)
+	}
+
+	return columns, nil
+}
+
+// String is the record in the text format
+func (record GameRecord) String() string {
+	var text strings.Builder
+
+	date := recordUnknownDate
+	if !record.Date.IsZero() {
+		date = record.Date.Format(recordDateLayout)
+	}
+
+	tags := [][2]string{
+		{"Player1", record.Player1},
+		{"Player2", record.Player2},
+		{"Date", date},
+		{"Seed", strconv.FormatInt(record.Seed, 10)},
+		{"Width", strconv.Itoa(record.Width)},
+		{"Height", strconv.Itoa(record.Height)},
+		{"Connect", strconv.Itoa(record.WinningLength)},
+		{"Result", record.Result},
+	}
+	if record.Termination != "" {
+		tags = append(tags, [2]string{"Termination", string(record.Termination)})
+	}
+
+	for _, tag := range tags {
+		fmt.Fprintf(&text, "[%s %s]\n", tag[0], strconv.Quote(tag[1]))
+	}
+	fmt.Fprintf(&text, "\n%s\n", FormatMoves(record.Moves, record.Width))
+
+	return text.String()
+}
+
+// WriteGameRecords writes the records with a blank line between each one
+func WriteGameRecords(w io.Writer, records []GameRecord) error {
+	for ndx, record := range records {
+		separator := ""
+		if ndx > 0 {
+			separator = "\n"
+		}
+
+		if _, err := io.WriteString(w, separator+record.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParseGameRecord reads a single game record
+func ParseGameRecord(text string) (GameRecord, error) {
+	records, err := ReadGameRecords(strings.NewReader(text))
+	if err != nil {
+		return GameRecord{}, err
+	}
+	if len(records) != 1 {
+		return GameRecord{}, fmt.Errorf("expected 1 game record but found %d", len(records))
+	}
+
+	return records[0], nil
+}
+
+// ReadGameRecords reads every game record in r
+func ReadGameRecords(r io.Reader) ([]GameRecord, error) {
+	records := []GameRecord{}
+	var record *GameRecord
+	var moves strings.Builder
+	lineNumber := 0
+	// headerEnded is set by a blank line or a moves line, after which a tag starts the next record
+	headerEnded := false
+
+	finish := func() error {
+		if record == nil {
+			return nil
+		}
+
+		columns, err := ParseMoves(moves.String(), record.Width)
+		if err != nil {
+			return fmt.Errorf("game record %d: %w", len(records)+1, err)
+		}
+		record.Moves = columns
+		records = append(records, *record)
+
+		record = nil
+		moves.Reset()
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			headerEnded = true
+		case strings.HasPrefix(line, "["):
+			if record != nil && headerEnded {
+				if err := finish(); err != nil {
+					return nil, err
+				}
+			}
+			headerEnded = false
+			if record == nil {
+				record = &GameRecord{Width: BoardWidth, Height: BoardHeight, WinningLength: WinningLength, Result: RecordResultUnfinished}
+			}
+			if err := record.setTag(line); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+		default:
+			if record == nil {
+				return nil, fmt.Errorf("line %d: moves before any header tags", lineNumber)
+			}
+			moves.WriteString(line + " ")
+			headerEnded = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := finish(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+var errMalformedTag = errors.New(`expected a header tag like [Name "value"]`)
+
+func (record *GameRecord) setTag(line string) error {
+	if !strings.HasSuffix(line, "]") {
+		return errMalformedTag
+	}
+
+	name, quotedValue, found := strings.Cut(line[1:len(line)-1], " ")
+	if !found {
+		return errMalformedTag
+	}
+	value, err := strconv.Unquote(strings.TrimSpace(quotedValue))
+	if err != nil {
+		return errMalformedTag
+	}
+
+	switch name {
+	case "Player1":
+		record.Player1 = value
+	case "Player2":
+		record.Player2 = value
+	case "Date":
+		if value != recordUnknownDate {
+			record.Date, err = time.Parse(recordDateLayout, value)
+		}
+	case "Seed":
+		record.Seed, err = strconv.ParseInt(value, 10, 64)
+	case "Width":
+		record.Width, err = parseRecordDimension(value)
+	case "Height":
+		record.Height, err = parseRecordDimension(value)
+	case "Connect":
+		record.WinningLength, err = parseRecordDimension(value)
+	case "Result":
+		switch value {
+		case RecordResultPlayer1Wins, RecordResultPlayer2Wins, RecordResultDraw, RecordResultUnfinished:
+			record.Result = value
+		default:
+			err = fmt.Errorf("unknown result")
+		}
+	case "Termination":
+		record.Termination = GameEndReason(value)
+	}
+	// other tags are allowed and ignored
+
+	if err != nil {
+		return fmt.Errorf("tag %s %q: %w", name, value, err)
+	}
+
+	return nil
+}
+
+// maxRecordDimension bounds the board a record can ask for, so a hostile record cannot make the reader allocate a huge board
+const maxRecordDimension = 64
+
+func parseRecordDimension(value string) (int, error) {
+	dimension, err := strconv.Atoi(value)
+	if err == nil && (dimension < 1 || dimension > maxRecordDimension) {
+		err = fmt.Errorf("must be between 1 and %d", maxRecordDimension)
+	}
+
+	return dimension, err
+}
+
+// GameBoard plays the moves of the record on a new board, player 1 has the value 1 and player 2 the value 2
+func (record GameRecord) GameBoard() (*GameBoard, error) {
+	gameBoard := NewGameBoardOfSize(record.Width, record.Height, record.WinningLength)
+
+	for ndx, column := range record.Moves {
+		if gameBoard.IsVictory() != NoPlayer {
+			return nil, fmt.Errorf("move %d is played after the game was won", ndx+1)
+		}
+
+		if err := gameBoard.PlayPiece(ndx%NumPlayers+1, column); err != nil {
+			return nil, fmt.Errorf("move %d in column %d: %w", ndx+1, column+1, err)
+		}
+	}
+
+	return gameBoard, nil
+}
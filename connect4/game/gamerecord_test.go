@@ -0,0 +1,137 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testGameRecord = `[Player1 "negamax"]
+[Player2 "blocker"]
+[Date "2026.10.18"]
+[Seed "12345"]
+[Width "7"]
+[Height "6"]
+[Connect "4"]
+[Result "1-0"]
+[Termination "connect"]
+
+4455667
+`
+
+func TestParseGameRecord(t *testing.T) {
+	record, err := ParseGameRecord(testGameRecord)
+	if err != nil {
+		t.Fatalf(`TestParseGameRecord returned error %v`, err)
+	}
+
+	expected := GameRecord{
+		Player1:       "negamax",
+		Player2:       "blocker",
+		Date:          time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC),
+		Seed:          12345,
+		Width:         7,
+		Height:        6,
+		WinningLength: 4,
+		Result:        RecordResultPlayer1Wins,
+		Termination:   GameEndConnect,
+		Moves:         []int{3, 3, 4, 4, 5, 5, 6},
+	}
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf(`TestParseGameRecord expected %+v but got %+v`, expected, record)
+	}
+	if record.String() != testGameRecord {
+		t.Errorf("TestParseGameRecord expected to write\n%s\nbut wrote\n%s", testGameRecord, record.String())
+	}
+}
+
+func TestGameRecordOfAPlayedGame(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "random"
+	config.Player2 = "blocker"
+	config.Output = nil
+	result := PlayConnect4(config)
+
+	records, err := ReadGameRecords(strings.NewReader(NewGameRecord(result).String()))
+	if err != nil || len(records) != 1 {
+		t.Fatalf(`TestGameRecordOfAPlayedGame expected 1 record but got %d: %v`, len(records), err)
+	}
+
+	gameBoard, err := records[0].GameBoard()
+	if err != nil {
+		t.Fatalf(`TestGameRecordOfAPlayedGame could not rebuild the board: %v`, err)
+	}
+	// records keep the moves played, not the illegal columns chosen before them
+	expected := make([]RecordedTurn, len(result.Turns))
+	for ndx, turn := range result.Turns {
+		turn.IllegalAttempts = nil
+		expected[ndx] = turn
+	}
+	if !reflect.DeepEqual(gameBoard.GetTurnHistory(), expected) || records[0].Seed != result.Seed {
+		t.Errorf(`TestGameRecordOfAPlayedGame expected %v but rebuilt %v`, expected, gameBoard.GetTurnHistory())
+	}
+	if NewGameRecordOfBoard(gameBoard).Result != records[0].Result {
+		t.Errorf(`TestGameRecordOfAPlayedGame expected the board to give the result %s but got %s`, records[0].Result, NewGameRecordOfBoard(gameBoard).Result)
+	}
+}
+
+func TestReadGameRecordsOfAWideBoard(t *testing.T) {
+	records := []GameRecord{
+		{Width: 12, Height: 6, WinningLength: 4, Result: RecordResultUnfinished, Moves: []int{11, 0, 9}},
+		{Width: 7, Height: 6, WinningLength: 4, Result: RecordResultDraw, Moves: []int{}},
+	}
+
+	var text strings.Builder
+	if err := WriteGameRecords(&text, records); err != nil {
+		t.Fatalf(`TestReadGameRecordsOfAWideBoard could not write: %v`, err)
+	}
+	read, err := ReadGameRecords(strings.NewReader(text.String()))
+
+	if err != nil || !reflect.DeepEqual(read, records) || !strings.Contains(text.String(), "\n12 1 10\n") {
+		t.Errorf("TestReadGameRecordsOfAWideBoard expected %+v but read %+v from\n%s", records, read, text.String())
+	}
+}
+
+func TestReadGameRecordsAfterARecordWithNoMoves(t *testing.T) {
+	records := []GameRecord{
+		{Player1: "human", Player2: "negamax", Width: 7, Height: 6, WinningLength: 4, Result: RecordResultUnfinished, Moves: []int{}},
+		{Player1: "negamax", Player2: "blocker", Width: 7, Height: 6, WinningLength: 4, Result: RecordResultUnfinished, Moves: []int{3, 3}},
+		{Width: 7, Height: 6, WinningLength: 4, Result: RecordResultUnfinished, Moves: []int{}},
+	}
+
+	var text strings.Builder
+	if err := WriteGameRecords(&text, records); err != nil {
+		t.Fatalf(`TestReadGameRecordsAfterARecordWithNoMoves could not write: %v`, err)
+	}
+	read, err := ReadGameRecords(strings.NewReader(text.String()))
+
+	if err != nil || !reflect.DeepEqual(read, records) {
+		t.Errorf("TestReadGameRecordsAfterARecordWithNoMoves expected %+v but read %+v from\n%s", records, read, text.String())
+	}
+}
+
+func TestGameRecordRejectsIllegalMoves(t *testing.T) {
+	record := GameRecord{Width: 7, Height: 2, WinningLength: 4, Moves: []int{0, 0, 0}}
+
+	if _, err := record.GameBoard(); !errors.Is(err, ErrColumnFull) {
+		t.Errorf(`TestGameRecordRejectsIllegalMoves expected %v but got %v`, ErrColumnFull, err)
+	}
+	if _, err := ParseGameRecord("[Width \"7\"]\n\n4485\n"); err == nil || !strings.Contains(err.Error(), "move 3") {
+		t.Errorf(`TestGameRecordRejectsIllegalMoves expected column 8 to be rejected but got %v`, err)
+	}
+	if _, err := ParseGameRecord("[Width 7]\n\n44\n"); err == nil {
+		t.Errorf(`TestGameRecordRejectsIllegalMoves expected an unquoted tag to be rejected`)
+	}
+}
+
+func TestParseGameRecordRejectsAHugeBoard(t *testing.T) {
+	for _, tag := range []string{"Width", "Height", "Connect"} {
+		_, err := ParseGameRecord(fmt.Sprintf("[%s \"2000000000\"]\n\n4\n", tag))
+		if err == nil || !strings.Contains(err.Error(), "must be between 1 and") {
+			t.Errorf(`TestParseGameRecordRejectsAHugeBoard expected the %s to be rejected but got %v`, tag, err)
+		}
+	}
+}
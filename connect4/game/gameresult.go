@@ -0,0 +1,83 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// GameEndReason is why a game of Connect 4 ended
+type GameEndReason string
+
+const (
+	GameEndConnect     GameEndReason = "connect"      // a player connected WinningLength pieces
+	GameEndDraw        GameEndReason = "draw"         // the board filled up without a connection
+	GameEndForfeit     GameEndReason = "forfeit"      // a strategy panicked while choosing a move
+	GameEndTimeout     GameEndReason = "timeout"      // a player ran out of time under the forfeit timeout policy
+	GameEndIllegalMove GameEndReason = "illegal move" // a player chose a column that could not be played
+	GameEndNotStarted  GameEndReason = "not started"  // the GameConfig could not be played
+	GameEndResign      GameEndReason = "resign"       // a player gave up the game
+
+	// GameEndInvalidPosition stops a game in debug mode when the board could not have come from a real game
+	GameEndInvalidPosition GameEndReason = "invalid position"
+)
+
+// Cell is a space on the board in [x][y] board coordinates, row 0 is the top of the board
+type Cell struct {
+	Column int
+	Row    int
+}
+
+// GameResult is everything PlayConnect4 knows about a finished game
+type GameResult struct {
+	Winner        int // the winning player value, NoPlayer for a draw
+	PlayerValues  [NumPlayers]int
+	PlayerNames   [NumPlayers]string
+	Strategies    [NumPlayers]string // the registered option names of the strategies that played
+	BoardWidth    int
+	BoardHeight   int
+	WinningLength int
+	EndReason     GameEndReason
+	EndedBy       int           // the player value who forfeited, timed out or played an illegal move
+	Turn          int           // the last turn of the game, counting from 0
+	Seed          int64         // replays the game when set as GameConfig.Seed
+	WinningLines  []WinningLine // every connection on the board when the game was won, the last move can make more than one
+	Turns         []RecordedTurn
+	ThinkTimes    []time.Duration // ThinkTimes[i] is the time taken to choose Turns[i]
+	StartedAt     time.Time
+	EndedAt       time.Time
+	Err           error               // why the game ended early, for every reason but connect and draw
+	Panic         *StrategyPanicError // the panic that forfeited the game
+}
+
+// PlayerName is the strategy name of the player value, or an empty string for NoPlayer
+func (result GameResult) PlayerName(playerValue int) string {
+	for playerNdx := range NumPlayers {
+		if result.PlayerValues[playerNdx] == playerValue {
+			return result.PlayerNames[playerNdx]
+		}
+	}
+
+	return ""
+}
+
+// Message describes the end of the game for the display
+func (result GameResult) Message() string {
+	switch result.EndReason {
+	case GameEndNotStarted:
+		return fmt.Sprintf(`The Match could not start: %v`, result.Err)
+	case GameEndInvalidPosition:
+		return fmt.Sprintf(`Turn %d the Match was stopped: %v`, result.Turn, result.Err)
+	case GameEndForfeit:
+		return fmt.Sprintf("Turn %d Player %d %v forfeits after a panic: %v. The Winner is Player %d %v\n%s", result.Turn, result.EndedBy, result.PlayerName(result.EndedBy), result.Panic.Value, result.Winner, result.PlayerName(result.Winner), result.Panic.Stack)
+	case GameEndResign:
+		return fmt.Sprintf(`Turn %d Player %d %v resigns. The Winner is Player %d %v`, result.Turn, result.EndedBy, result.PlayerName(result.EndedBy), result.Winner, result.PlayerName(result.Winner))
+	case GameEndTimeout:
+		return fmt.Sprintf(`Turn %d Player %d %v ran out of time. The Winner is Player %d %v`, result.Turn, result.EndedBy, result.PlayerName(result.EndedBy), result.Winner, result.PlayerName(result.Winner))
+	case GameEndIllegalMove:
+		return fmt.Sprintf(`Turn %d Player %d %v forfeits with an illegal move: %v. The Winner is Player %d %v`, result.Turn, result.EndedBy, result.PlayerName(result.EndedBy), result.Err, result.Winner, result.PlayerName(result.Winner))
+	case GameEndDraw:
+		return fmt.Sprintf(`Turn %d the Winner is Neither Player. The Match has ended in a tie`, result.Turn)
+	default:
+		return fmt.Sprintf(`Turn %d the Winner is Player %d %v`, result.Turn, result.Winner, result.PlayerName(result.Winner))
+	}
+}
@@ -0,0 +1,77 @@
+package game
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGameResultOfAConnection(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "firstavailable"
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndConnect || result.Winner != 1 || result.Err != nil {
+		t.Errorf(`TestGameResultOfAConnection expected player 1 to connect but got %v by %d: %v`, result.EndReason, result.Winner, result.Err)
+	}
+	if len(result.Turns) != result.Turn+1 || len(result.ThinkTimes) != len(result.Turns) {
+		t.Errorf(`TestGameResultOfAConnection expected %d turns and think times but got %d and %d`, result.Turn+1, len(result.Turns), len(result.ThinkTimes))
+	}
+	if len(result.WinningLines) != 1 || result.WinningLines[0].PlayerValue != 1 || len(result.WinningLines[0].Cells) < WinningLength {
+		t.Errorf(`TestGameResultOfAConnection expected the winning line but got %v`, result.WinningLines)
+	}
+	if result.Strategies != [NumPlayers]string{"firstavailable", "firstavailable"} {
+		t.Errorf(`TestGameResultOfAConnection expected the strategy option names but got %v`, result.Strategies)
+	}
+	if result.PlayerNames[0] != "First Available Move Strategy" || result.EndedAt.Before(result.StartedAt) {
+		t.Errorf(`TestGameResultOfAConnection recorded players %v from %v to %v`, result.PlayerNames, result.StartedAt, result.EndedAt)
+	}
+	if !strings.HasPrefix(result.Message(), "Turn ") || !strings.Contains(result.Message(), "the Winner is Player 1") {
+		t.Errorf(`TestGameResultOfAConnection message: %v`, result.Message())
+	}
+}
+
+func TestGameResultOfAnIllegalMove(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "testoutofrange"
+	config.IllegalMovePolicy = IllegalMovePolicyForfeit
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndIllegalMove || result.EndedBy != 2 || result.Winner != 1 || len(result.Turns) != 1 {
+		t.Errorf(`TestGameResultOfAnIllegalMove expected player 2 to forfeit on turn 1 but got %+v`, result)
+	}
+}
+
+func TestGameResultNotStarted(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.TimeoutPolicy = "ignore"
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndNotStarted || result.Winner != NoPlayer || !strings.HasPrefix(result.Message(), "The Match could not start") {
+		t.Errorf(`TestGameResultNotStarted expected the game not to start but got %v: %v`, result.EndReason, result.Message())
+	}
+}
+
+func TestGameResultReplaysFromItsSeed(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "random"
+	config.Player2 = "mcts"
+	config.TimeoutPolicy = TimeoutPolicyRandomMove
+	config.Output = nil
+
+	result := PlayConnect4(config)
+	config.Seed = result.Seed
+	replay := PlayConnect4(config)
+
+	if result.Seed == 0 || replay.Seed != result.Seed {
+		t.Errorf(`TestGameResultReplaysFromItsSeed expected the seed %d to be recorded but got %d`, result.Seed, replay.Seed)
+	}
+	if !reflect.DeepEqual(result.Turns, replay.Turns) {
+		t.Errorf(`TestGameResultReplaysFromItsSeed expected %v but the replay was %v`, result.Turns, replay.Turns)
+	}
+}
@@ -0,0 +1,76 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+const IllegalMovePolicyForfeit string = "forfeit"
+const IllegalMovePolicyRetry string = "retry"
+const IllegalMovePolicySubstitute string = "substitute"
+
+// errOutOfTime is returned when a player asked to choose again runs out of time under the forfeit timeout policy
+var errOutOfTime = errors.New("ran out of time")
+
+// errResigned is returned when a player chooses StatusResign
+var errResigned = errors.New("resigned")
+
+// playChosenColumn plays the column, applying config.IllegalMovePolicy until a piece is placed
+//
+// It reports whether the piece placed made a connection. It returns ErrBoardFull if no piece can be placed, or another error if the player forfeits,
+// which is a *StrategyPanicError when the player panics while choosing again.
+// Illegal columns are recorded on the turn that is eventually played.
+func playChosenColumn(config GameConfig, runner *strategyRunner, clock *playerClock, gameBoard engineBoard, playerValue int, column int) (bool, error) {
+	illegalAttempts := []int{}
+
+	for {
+		won, err := gameBoard.PlayPieceAndCheck(playerValue, column)
+		if err == nil {
+			gameBoard.recordIllegalAttempts(illegalAttempts)
+			return won, nil
+		}
+		if errors.Is(err, ErrBoardFull) {
+			return false, err
+		}
+
+		illegalAttempts = append(illegalAttempts, column)
+
+		switch config.IllegalMovePolicy {
+		case IllegalMovePolicyForfeit:
+			return false, err
+		case IllegalMovePolicyRetry:
+			if len(illegalAttempts) > config.IllegalMoveRetries {
+				return false, fmt.Errorf("%w after %d attempts", err, len(illegalAttempts))
+			}
+
+			var timedOut bool
+			var panicErr error
+			column, timedOut, panicErr = runner.chooseMoveFor(config, clock, gameBoard)
+			if panicErr != nil {
+				return false, panicErr
+			}
+			if timedOut {
+				if config.TimeoutPolicy == TimeoutPolicyForfeit {
+					return false, errOutOfTime
+				}
+				column = timeoutSubstitute(config.TimeoutPolicy, playerValue, gameBoard, runner.rng)
+			}
+		default:
+			column = firstAvailableColumn(gameBoard)
+		}
+	}
+}
+
+// ValidateIllegalMovePolicy reports illegal move settings PlayConnect4 cannot use
+func (config GameConfig) ValidateIllegalMovePolicy() error {
+	if config.IllegalMoveRetries < 0 {
+		return fmt.Errorf("illegal move retries cannot be negative")
+	}
+
+	switch config.IllegalMovePolicy {
+	case IllegalMovePolicyForfeit, IllegalMovePolicyRetry, IllegalMovePolicySubstitute, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown illegal move policy %q, expected %s, %s or %s", config.IllegalMovePolicy, IllegalMovePolicyForfeit, IllegalMovePolicyRetry, IllegalMovePolicySubstitute)
+	}
+}
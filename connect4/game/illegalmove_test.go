@@ -0,0 +1,170 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func init() {
+	Register("testoutofrange", NewPlayerStrategyOutOfRange)
+	Register("testundo", func(playerValue int) PlayerStrategy {
+		return &PlayerStrategyStatus{PlayerStrategyFirstAvailableMove{name: "Undo Strategy", playerValue: playerValue}, StatusUndoRequest}
+	})
+	Register("testresign", func(playerValue int) PlayerStrategy {
+		return &PlayerStrategyStatus{PlayerStrategyFirstAvailableMove{name: "Resign Strategy", playerValue: playerValue}, StatusResign}
+	})
+}
+
+// PlayerStrategyOutOfRange always chooses a column that isn't on the board
+type PlayerStrategyOutOfRange struct {
+	PlayerStrategyFirstAvailableMove
+}
+
+func NewPlayerStrategyOutOfRange(playerValue int) PlayerStrategy {
+	return &PlayerStrategyOutOfRange{PlayerStrategyFirstAvailableMove{name: "Out Of Range Strategy", playerValue: playerValue}}
+}
+
+func (p PlayerStrategyOutOfRange) PlayerChoosesAMove(gameBoard BoardView) int {
+	return 99
+}
+
+// PlayerStrategyStatus always chooses the same status instead of a column
+type PlayerStrategyStatus struct {
+	PlayerStrategyFirstAvailableMove
+	status int
+}
+
+func (p PlayerStrategyStatus) PlayerChoosesAMove(gameBoard BoardView) int {
+	return p.status
+}
+
+// PlayerStrategyScripted plays its columns in order
+type PlayerStrategyScripted struct {
+	PlayerStrategyFirstAvailableMove
+	columns []int
+}
+
+func (p *PlayerStrategyScripted) PlayerChoosesAMove(gameBoard BoardView) int {
+	column := p.columns[0]
+	p.columns = p.columns[1:]
+	return column
+}
+
+func TestIllegalMoveForfeits(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "testoutofrange"
+	config.Player2 = "firstavailable"
+	config.IllegalMovePolicy = IllegalMovePolicyForfeit
+
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 2 || !strings.Contains(message, "illegal move") {
+		t.Errorf(`TestIllegalMoveForfeits expected player 2 to win by forfeit but got %d: %v`, winner, message)
+	}
+}
+
+func TestOnlyAPersonCanUndoOrResign(t *testing.T) {
+	for _, strategy := range []string{"testundo", "testresign"} {
+		config := NewDefaultGameConfig()
+		config.Player1 = "firstavailable"
+		config.Player2 = strategy
+		config.Output = nil
+
+		result := PlayConnect4(config)
+
+		if result.EndReason != GameEndConnect || result.Winner != 1 {
+			t.Errorf(`TestOnlyAPersonCanUndoOrResign expected player 1 to win against %s but got %v`, strategy, result.Message())
+		}
+		if len(result.Turns) < 2 || len(result.Turns[1].IllegalAttempts) != 1 {
+			t.Errorf(`TestOnlyAPersonCanUndoOrResign expected the status chosen by %s to be recorded as an illegal move but got %+v`, strategy, result.Turns)
+		}
+
+		config.IllegalMovePolicy = IllegalMovePolicyForfeit
+		result = PlayConnect4(config)
+
+		if result.EndReason != GameEndIllegalMove || result.EndedBy != 2 || result.Turn != 1 {
+			t.Errorf(`TestOnlyAPersonCanUndoOrResign expected %s to forfeit its first move but got %v`, strategy, result.Message())
+		}
+	}
+}
+
+func TestIllegalMoveIsSubstitutedAndRecorded(t *testing.T) {
+	config := NewDefaultGameConfig()
+	gameBoard := NewGameBoard()
+	runner := &strategyRunner{player: NewPlayerStrategyOutOfRange(1)}
+
+	_, err := playChosenColumn(config, runner, &playerClock{}, gameBoard, 1, 99)
+	if err != nil {
+		t.Fatalf(`TestIllegalMoveIsSubstitutedAndRecorded returned error %v`, err)
+	}
+
+	expected := RecordedTurn{PlayerValue: 1, Column: 3, Row: BoardHeight - 1, IllegalAttempts: []int{99}}
+	if turnHistory := gameBoard.GetTurnHistory(); len(turnHistory) != 1 || !reflect.DeepEqual(turnHistory[0], expected) {
+		t.Errorf(`TestIllegalMoveIsSubstitutedAndRecorded expected %+v but recorded %+v`, expected, turnHistory)
+	}
+}
+
+func TestIllegalMoveRetryAsksAgain(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.IllegalMovePolicy = IllegalMovePolicyRetry
+	config.IllegalMoveRetries = 2
+	gameBoard := NewGameBoard()
+	player := &PlayerStrategyScripted{columns: []int{-1, 5}}
+	player.playerValue = 1
+
+	_, err := playChosenColumn(config, &strategyRunner{player: player}, &playerClock{}, gameBoard, 1, 12)
+	if err != nil {
+		t.Fatalf(`TestIllegalMoveRetryAsksAgain returned error %v`, err)
+	}
+
+	expected := RecordedTurn{PlayerValue: 1, Column: 5, Row: BoardHeight - 1, IllegalAttempts: []int{12, -1}}
+	if turnHistory := gameBoard.GetTurnHistory(); len(turnHistory) != 1 || !reflect.DeepEqual(turnHistory[0], expected) {
+		t.Errorf(`TestIllegalMoveRetryAsksAgain expected %+v but recorded %+v`, expected, turnHistory)
+	}
+}
+
+func TestIllegalMoveRetryGivesUp(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "testoutofrange"
+	config.IllegalMovePolicy = IllegalMovePolicyRetry
+	config.IllegalMoveRetries = 2
+
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 1 || !strings.Contains(message, "after 3 attempts") {
+		t.Errorf(`TestIllegalMoveRetryGivesUp expected player 1 to win by forfeit but got %d: %v`, winner, message)
+	}
+}
+
+func TestPlayChosenColumnOnFullBoard(t *testing.T) {
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{1, 1, 2, 1, 2, 1, 2},
+		{2, 1, 2, 1, 2, 1, 2},
+		{1, 2, 1, 2, 1, 2, 1},
+		{1, 2, 1, 2, 1, 2, 1},
+		{2, 1, 2, 1, 2, 1, 2},
+		{2, 1, 2, 1, 2, 1, 2},
+	}
+	config := NewDefaultGameConfig()
+	config.IllegalMovePolicy = IllegalMovePolicyForfeit
+
+	_, err := playChosenColumn(config, &strategyRunner{}, &playerClock{}, NewInProgressGameBoard(thisBoard), 1, 3)
+
+	if !errors.Is(err, ErrBoardFull) {
+		t.Errorf(`TestPlayChosenColumnOnFullBoard expected %v but got %v`, ErrBoardFull, err)
+	}
+}
+
+func TestValidateIllegalMovePolicyRejectsUnknownPolicy(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.IllegalMovePolicy = "ignore"
+
+	if err := config.ValidateIllegalMovePolicy(); err == nil {
+		t.Errorf(`TestValidateIllegalMovePolicyRejectsUnknownPolicy expected an unknown policy to be rejected`)
+	}
+}
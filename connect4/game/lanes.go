@@ -0,0 +1,157 @@
+package game
+
+import (
+	"slices"
+	"sync"
+)
+
+// Direction is the way a line of pieces runs across the board
+type Direction string
+
+const DirectionHorizontal Direction = "horizontal"
+const DirectionVertical Direction = "vertical"
+const DirectionDiagonalDown Direction = "diagonal down" // from the top left to the bottom right
+const DirectionDiagonalUp Direction = "diagonal up"     // from the bottom left to the top right
+
+// directionStep is the column and row step from one cell of a line to the next, rows are numbered from the top
+type directionStep struct {
+	direction Direction
+	column    int
+	row       int
+}
+
+var directionSteps = []directionStep{
+	{DirectionHorizontal, 1, 0},
+	{DirectionVertical, 0, 1},
+	{DirectionDiagonalDown, 1, 1},
+	{DirectionDiagonalUp, 1, -1},
+}
+
+// Lane is a whole row, column or diagonal of the board, its cells in order from one edge to the other
+type Lane struct {
+	Direction Direction
+	Cells     []Cell
+}
+
+type laneSize struct {
+	width         int
+	height        int
+	winningLength int
+}
+
+var laneCache = make(map[laneSize][]Lane)
+var laneCacheMutex sync.RWMutex
+
+// boardLanes lists every lane of a board that is long enough to hold a connection, by direction and then from the top left
+//
+// The lanes are derived from the board dimensions alone, a lane starts at every cell whose previous cell
+// in that direction is off the board. They are built once per board size and shared, so must not be changed.
+func boardLanes(width int, height int, winningLength int) []Lane {
+	size := laneSize{width: width, height: height, winningLength: winningLength}
+
+	laneCacheMutex.RLock()
+	lanes, found := laneCache[size]
+	laneCacheMutex.RUnlock()
+	if found {
+		return lanes
+	}
+
+	lanes = []Lane{}
+	for _, step := range directionSteps {
+		for x := range width {
+			for y := range height {
+				if onBoard(width, height, x-step.column, y-step.row) {
+					continue
+				}
+
+				if lane := laneFrom(width, height, x, y, step); len(lane.Cells) >= winningLength {
+					lanes = append(lanes, lane)
+				}
+			}
+		}
+	}
+
+	laneCacheMutex.Lock()
+	laneCache[size] = lanes
+	laneCacheMutex.Unlock()
+
+	return lanes
+}
+
+func onBoard(width int, height int, column int, row int) bool {
+	return column >= 0 && column < width && row >= 0 && row < height
+}
+
+// laneFrom walks from the cell to the edge of the board
+func laneFrom(width int, height int, column int, row int, step directionStep) Lane {
+	lane := Lane{Direction: step.direction}
+	for ; onBoard(width, height, column, row); column, row = column+step.column, row+step.row {
+		lane.Cells = append(lane.Cells, Cell{Column: column, Row: row})
+	}
+
+	return lane
+}
+
+// laneWinner is the owner of the first run of winningLength pieces in the lane, NoPlayer when there is none
+func laneWinner(gameBoard boardReader, lane Lane) int {
+	piecesInARow := 0
+	prevSpaceOwner := NoPlayer
+
+	for _, cell := range lane.Cells {
+		owner := gameBoard.GetSpaceOwnership(cell.Column, cell.Row)
+
+		if owner == NoPlayer {
+			prevSpaceOwner = NoPlayer
+			piecesInARow = 0
+			continue
+		}
+
+		if owner == prevSpaceOwner {
+			piecesInARow++
+		} else {
+			piecesInARow = 1
+			prevSpaceOwner = owner
+		}
+
+		if piecesInARow >= gameBoard.GetWinningLength() {
+			return owner
+		}
+	}
+
+	return NoPlayer
+}
+
+// laneRuns lists every run of winningLength or more pieces of one player in the lane
+func laneRuns(gameBoard boardReader, lane Lane) []WinningLine {
+	runs := []WinningLine{}
+	start := 0
+
+	for end := 1; end <= len(lane.Cells); end++ {
+		owner := gameBoard.GetSpaceOwnership(lane.Cells[start].Column, lane.Cells[start].Row)
+		if end < len(lane.Cells) && gameBoard.GetSpaceOwnership(lane.Cells[end].Column, lane.Cells[end].Row) == owner {
+			continue
+		}
+
+		if owner != NoPlayer && end-start >= gameBoard.GetWinningLength() {
+			runs = append(runs, WinningLine{PlayerValue: owner, Direction: lane.Direction, Cells: append([]Cell(nil), lane.Cells[start:end]...)})
+		}
+		start = end
+	}
+
+	return runs
+}
+
+// victoryInLanes is the owner of the first connection found in the lanes, NoPlayer when there is none
+func victoryInLanes(gameBoard boardReader, lanes []Lane, directions ...Direction) int {
+	for _, lane := range lanes {
+		if len(directions) > 0 && !slices.Contains(directions, lane.Direction) {
+			continue
+		}
+
+		if winner := laneWinner(gameBoard, lane); winner != NoPlayer {
+			return winner
+		}
+	}
+
+	return NoPlayer
+}
@@ -0,0 +1,135 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// connectionWindow is one place a connection can be made, generated without boardLanes
+type connectionWindow struct {
+	direction Direction
+	cells     []Cell
+}
+
+// everyConnectionWindow places winningLength cells in a line from every cell of the board in every direction
+func everyConnectionWindow(width int, height int, winningLength int) []connectionWindow {
+	steps := map[Direction][2]int{
+		DirectionHorizontal:   {1, 0},
+		DirectionVertical:     {0, 1},
+		DirectionDiagonalDown: {1, 1},
+		DirectionDiagonalUp:   {1, -1},
+	}
+
+	windows := []connectionWindow{}
+	for direction, step := range steps {
+		for x := range width {
+			for y := range height {
+				endX, endY := x+step[0]*(winningLength-1), y+step[1]*(winningLength-1)
+				if endX < 0 || endX >= width || endY < 0 || endY >= height {
+					continue
+				}
+
+				window := connectionWindow{direction: direction}
+				for ndx := range winningLength {
+					window.cells = append(window.cells, Cell{Column: x + step[0]*ndx, Row: y + step[1]*ndx})
+				}
+				windows = append(windows, window)
+			}
+		}
+	}
+
+	return windows
+}
+
+func directionVictory(gameBoard *GameBoard, direction Direction) int {
+	switch direction {
+	case DirectionHorizontal:
+		return gameBoard.IsHorizontalVictory()
+	case DirectionVertical:
+		return gameBoard.IsVerticalVictory()
+	default:
+		return gameBoard.IsDiagonalVictory()
+	}
+}
+
+func TestEveryConnectionIsDetected(t *testing.T) {
+	sizes := [][3]int{{7, 6, 4}, {4, 4, 4}, {9, 7, 5}, {5, 8, 3}, {10, 3, 3}, {6, 1, 4}, {1, 6, 4}, {3, 3, 2}}
+
+	for _, size := range sizes {
+		width, height, winningLength := size[0], size[1], size[2]
+
+		for _, window := range everyConnectionWindow(width, height, winningLength) {
+			gameBoard := NewGameBoardOfSize(width, height, winningLength)
+			for _, cell := range window.cells {
+				gameBoard.board[cell.Column][cell.Row] = 1
+			}
+
+			if gameBoard.IsVictory() != 1 || directionVictory(gameBoard, window.direction) != 1 {
+				t.Errorf(`TestEveryConnectionIsDetected %dx%d connect %d missed the %s connection %v`, width, height, winningLength, window.direction, window.cells)
+			}
+
+			expected := []WinningLine{{PlayerValue: 1, Direction: window.direction, Cells: window.cells}}
+			if lines := gameBoard.WinningLines(); !reflect.DeepEqual(lines, expected) {
+				t.Errorf(`TestEveryConnectionIsDetected %dx%d connect %d expected %v but got %v`, width, height, winningLength, expected, lines)
+			}
+
+			gameBoard.board[window.cells[0].Column][window.cells[0].Row] = NoPlayer
+			if gameBoard.IsVictory() != NoPlayer {
+				t.Errorf(`TestEveryConnectionIsDetected %dx%d connect %d found a connection in %v which is one piece short`, width, height, winningLength, window.cells[1:])
+			}
+
+			if !BitBoardFits(width, height) {
+				continue
+			}
+			bitBoard, err := NewBitBoard(width, height, winningLength, [NumPlayers]int{1, 2})
+			if err != nil {
+				t.Fatalf(`TestEveryConnectionIsDetected returned error %v`, err)
+			}
+			for _, cell := range window.cells {
+				bitBoard.pieces[0] |= bitBoard.cellBit(cell.Column, cell.Row)
+			}
+			if bitBoard.IsVictory() != 1 {
+				t.Errorf(`TestEveryConnectionIsDetected %dx%d connect %d bitboard missed the %s connection %v`, width, height, winningLength, window.direction, window.cells)
+			}
+		}
+	}
+}
+
+func TestBoardLanesCoverEveryConnection(t *testing.T) {
+	sizes := [][3]int{{7, 6, 4}, {9, 7, 5}, {5, 8, 3}, {6, 1, 4}, {2, 2, 3}}
+
+	for _, size := range sizes {
+		width, height, winningLength := size[0], size[1], size[2]
+
+		windows := 0
+		for _, lane := range boardLanes(width, height, winningLength) {
+			windows += len(lane.Cells) - winningLength + 1
+		}
+
+		if expected := len(everyConnectionWindow(width, height, winningLength)); windows != expected {
+			t.Errorf(`TestBoardLanesCoverEveryConnection %dx%d connect %d expected lanes holding %d connections but got %d`, width, height, winningLength, expected, windows)
+		}
+	}
+}
+
+func TestStandardBoardLanes(t *testing.T) {
+	counts := map[Direction]int{}
+	for _, lane := range boardLanes(BoardWidth, BoardHeight, WinningLength) {
+		counts[lane.Direction]++
+	}
+
+	expected := map[Direction]int{DirectionHorizontal: 6, DirectionVertical: 7, DirectionDiagonalDown: 6, DirectionDiagonalUp: 6}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf(`TestStandardBoardLanes expected %v but got %v`, expected, counts)
+	}
+}
+
+func TestPlayPieceInTheEdgeColumns(t *testing.T) {
+	for name, gameBoard := range newTestBoards(t) {
+		for _, column := range []int{0, BoardWidth - 1} {
+			if err := gameBoard.PlayPiece(1, column); err != nil {
+				t.Errorf(`TestPlayPieceInTheEdgeColumns %s could not play column %d: %v`, name, column, err)
+			}
+		}
+	}
+}
@@ -2,22 +2,43 @@ package game
 
 import (
 	"fmt"
+	"math/rand"
+	"slices"
 	"strings"
 	"sync"
 )
 
 type PlayerStrategy interface {
-	PlayerChoosesAMove(GameBoardActions) int
+	PlayerChoosesAMove(BoardView) int
 	GetName() string
 	GetPlayerValue() int
 }
 
+// gameConfigured is a PlayerStrategy that takes settings from the GameConfig of the game it is playing
+// The engine calls useGameConfig once, after creating the strategy and before its first move.
+type gameConfigured interface {
+	useGameConfig(config GameConfig)
+}
+
 type PlayerStrategyFactory func(ownershipValue int) PlayerStrategy // this is what PlayerStrategy constructors should look like
-var playerRegistry = make(map[string]PlayerStrategyFactory)        // playerRegistry is the central map storing constructors/factories.
+
+// SeededPlayerStrategyFactory is the constructor for a PlayerStrategy that makes random choices
+// The strategy should draw every random number from rng so that a game can be replayed from its seed.
+type SeededPlayerStrategyFactory func(ownershipValue int, rng *rand.Rand) PlayerStrategy
+
+var playerRegistry = make(map[string]SeededPlayerStrategyFactory) // playerRegistry is the central map storing constructors/factories.
+var interactiveRegistry = make(map[string]bool)                   // interactiveRegistry marks the strategies played by a person
 var registryMutex sync.RWMutex
 
 // Register adds a new PlayerStrategy constructor to the registry.
 func Register(optionName string, constructor PlayerStrategyFactory) {
+	RegisterSeeded(optionName, func(ownershipValue int, rng *rand.Rand) PlayerStrategy {
+		return constructor(ownershipValue)
+	})
+}
+
+// RegisterSeeded adds a new constructor for a PlayerStrategy that makes random choices to the registry.
+func RegisterSeeded(optionName string, constructor SeededPlayerStrategyFactory) {
 	registryMutex.Lock()
 	defer registryMutex.Unlock()
 	if _, exists := playerRegistry[optionName]; exists {
@@ -27,23 +48,74 @@ func Register(optionName string, constructor PlayerStrategyFactory) {
 	playerRegistry[optionName] = constructor
 }
 
+// RegisterInteractive adds a PlayerStrategy played by a person to the registry.
+// Interactive strategies are left out of GetRegisteredAutomatedPlayerStrategyNames.
+// The constructor is given the game's rng for anything the strategy does at random, like a hint.
+func RegisterInteractive(optionName string, constructor SeededPlayerStrategyFactory) {
+	RegisterSeeded(optionName, constructor)
+
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+	interactiveRegistry[optionName] = true
+}
+
+// isInteractive reports whether the strategy was registered with RegisterInteractive
+func isInteractive(optionName string) bool {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	return interactiveRegistry[optionName]
+}
+
 // GetRegisteredPlayerStrategy retrieves a PlayerStrategy instance by name.
+// A strategy that makes random choices gets its own randomly seeded source.
 func GetRegisteredPlayerStrategy(name string, playerValue int) PlayerStrategy {
+	return GetRegisteredSeededPlayerStrategy(name, playerValue, newRandomlySeededRand())
+}
+
+// GetRegisteredSeededPlayerStrategy retrieves a PlayerStrategy instance by name that draws its random numbers from rng.
+func GetRegisteredSeededPlayerStrategy(name string, playerValue int, rng *rand.Rand) PlayerStrategy {
 	registryMutex.RLock()
 	defer registryMutex.RUnlock()
 	constructor, exists := playerRegistry[name]
 	if !exists {
 		return nil // Or return an error
 	}
-	return constructor(playerValue)
+	return constructor(playerValue, rng)
 }
 
-func GetHelpMessageOfPlayerRegistry() string {
+// newRandomlySeededRand is the source for strategies built without a seed
+func newRandomlySeededRand() *rand.Rand {
+	return rand.New(rand.NewSource(rand.Int63()))
+}
+
+// GetRegisteredPlayerStrategyNames lists every registered option name in alphabetical order
+func GetRegisteredPlayerStrategyNames() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
 	var keys []string
 	for k := range playerRegistry {
 		keys = append(keys, k)
 	}
-	message := "Options: " + strings.Join(keys, ", ")
+	slices.Sort(keys)
+	return keys
+}
+
+// GetRegisteredAutomatedPlayerStrategyNames lists every registered option name that is not played by a person, in alphabetical order
+func GetRegisteredAutomatedPlayerStrategyNames() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+	var keys []string
+	for k := range playerRegistry {
+		if !interactiveRegistry[k] {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func GetHelpMessageOfPlayerRegistry() string {
+	message := "Options: " + strings.Join(GetRegisteredPlayerStrategyNames(), ", ")
 	return message
 }
 
@@ -72,10 +144,12 @@ func (p PlayerStrategyFirstAvailableMove) GetPlayerValue() int {
 	return p.playerValue
 }
 
-func (p PlayerStrategyFirstAvailableMove) PlayerChoosesAMove(gameBoard GameBoardActions) int {
-	columnPriority := [BoardWidth]int{3, 2, 4, 1, 5, 0, 6}
+func (p PlayerStrategyFirstAvailableMove) PlayerChoosesAMove(gameBoard BoardView) int {
+	return firstAvailableColumn(gameBoard)
+}
 
-	for _, column := range columnPriority {
+func firstAvailableColumn(gameBoard boardReader) int {
+	for _, column := range CenterFirstColumnOrder(gameBoard.GetWidth()) {
 		if gameBoard.AvailableRow(column) != StatusRowIsFull {
 			return column
 		}
@@ -83,3 +157,21 @@ func (p PlayerStrategyFirstAvailableMove) PlayerChoosesAMove(gameBoard GameBoard
 
 	return StatusNoAvailableMove
 }
+
+// CenterFirstColumnOrder lists every column starting at the center and working outwards, left before right
+// On the standard board this is {3, 2, 4, 1, 5, 0, 6}
+func CenterFirstColumnOrder(width int) []int {
+	center := width / 2
+	columns := []int{center}
+
+	for offset := 1; len(columns) < width; offset++ {
+		if center-offset >= 0 {
+			columns = append(columns, center-offset)
+		}
+		if center+offset < width {
+			columns = append(columns, center+offset)
+		}
+	}
+
+	return columns
+}
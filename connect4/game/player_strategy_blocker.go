@@ -22,7 +22,7 @@ func (p PlayerStrategyBlocker) GetPlayerValue() int {
 	return p.playerValue
 }
 
-func (p PlayerStrategyBlocker) PlayerChoosesAMove(gba GameBoardActions) int {
+func (p PlayerStrategyBlocker) PlayerChoosesAMove(gba BoardView) int {
 	// check the board for any three-in-row
 	// block that move if can
 
@@ -31,8 +31,8 @@ func (p PlayerStrategyBlocker) PlayerChoosesAMove(gba GameBoardActions) int {
 
 	// just start looking from the first column to the next-to-last
 NextColumnLoop:
-	for i := 0; i < (BoardWidth - 1); i++ {
-		for j := 0; j < BoardHeight; j++ {
+	for i := 0; i < (gba.GetWidth() - 1); i++ {
+		for j := 0; j < gba.GetHeight(); j++ {
 			owner := gba.GetSpaceOwnership(i, j)
 			switch owner {
 			case NoPlayer:
@@ -57,7 +57,7 @@ NextColumnLoop:
 	// see if we can drop one in there
 	// we'll first try the candidate, if any, then
 	// fall back to a "center first" strategy
-	candidates := append(blocker, []int{3, 2, 4, 1, 5, 0, 6}...)
+	candidates := append(blocker, CenterFirstColumnOrder(gba.GetWidth())...)
 	for _, c := range candidates {
 		if gba.AvailableRow(c) != StatusRowIsFull {
 			return c
@@ -8,7 +8,7 @@ func TestBlockerEmptyBoard(t *testing.T) {
 	player := NewPlayerStrategyBlocker(1)
 	gameBoard := NewGameBoard()
 
-	chosenColumn := player.PlayerChoosesAMove(*gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	// with nothing else on the board, we should always get the center column
 	if chosenColumn != 3 {
@@ -33,7 +33,7 @@ func TestBlockerThreeInARow(t *testing.T) {
 	}
 
 	board := NewInProgressGameBoard(textBoard)
-	chosenColumn := player.PlayerChoosesAMove(board)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(board, player.GetPlayerValue()))
 
 	// we should be seeing the block here!
 	if chosenColumn != expected {
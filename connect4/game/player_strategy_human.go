@@ -0,0 +1,223 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultHumanHintStrategy is the option name of the strategy a human player asks for a hint, see GameConfig.HumanHint
+const DefaultHumanHintStrategy = "negamax"
+
+// stdinReader is shared so that human players reading the same terminal, a line or a key at a time,
+// never lose input buffered by another reader
+var stdinReader = sync.OnceValue(func() *bufio.Reader {
+	return bufio.NewReader(os.Stdin)
+})
+
+func init() {
+	RegisterInteractive("human", NewPlayerStrategyHuman)
+}
+
+// PlayerStrategyHuman asks a person at the terminal for each move
+//
+// The player types a column numbered from 1, or one of the commands hint, undo or resign.
+// On a TerminalUI the player moves a cursor over the columns instead, see TerminalUI.chooseColumn.
+type PlayerStrategyHuman struct {
+	playerValue int
+	input       *bufio.Reader // read a line at a time, or a key at a time on a TerminalUI
+	output      io.Writer
+	hint        string
+	renderer    Renderer
+	terminalUI  *TerminalUI
+	keyMode     func() (func(), error) // switches the terminal to reading single keys, nil when it already does
+	readsStdin  bool                   // a player at the terminal can use the game's TerminalUI
+	rng         *rand.Rand             // seeds the hint strategy, nil seeds it at random
+}
+
+func NewPlayerStrategyHuman(playerValue int, rng *rand.Rand) PlayerStrategy {
+	return &PlayerStrategyHuman{
+		playerValue: playerValue,
+		input:       stdinReader(),
+		output:      os.Stdout,
+		readsStdin:  true,
+		rng:         rng,
+	}
+}
+
+// NewPlayerStrategyHumanWithIO reads the player's moves from input and writes the board and prompts to output
+// A hint asks the strategy registered as hint for its move, an empty hint uses the game's GameConfig.HumanHint.
+func NewPlayerStrategyHumanWithIO(playerValue int, input io.Reader, output io.Writer, hint string) PlayerStrategy {
+	return &PlayerStrategyHuman{
+		playerValue: playerValue,
+		input:       bufio.NewReader(input),
+		output:      output,
+		hint:        hint,
+	}
+}
+
+// NewPlayerStrategyHumanWithTerminalUI reads the keys that move the player's cursor on terminalUI
+func NewPlayerStrategyHumanWithTerminalUI(playerValue int, keys io.Reader, terminalUI *TerminalUI, hint string) PlayerStrategy {
+	return &PlayerStrategyHuman{
+		playerValue: playerValue,
+		input:       bufio.NewReader(keys),
+		output:      io.Discard,
+		hint:        hint,
+		terminalUI:  terminalUI,
+	}
+}
+
+// useGameConfig takes the hint strategy and renderer from the game, unless they were given to the constructor,
+// and has a player at the terminal choose with the cursor of the game's TerminalUI
+func (p *PlayerStrategyHuman) useGameConfig(config GameConfig) {
+	if p.hint == "" {
+		p.hint = config.HumanHint
+	}
+
+	if p.renderer == nil {
+		p.renderer = config.Renderer
+	}
+
+	if config.TerminalUI != nil && p.terminalUI == nil && p.readsStdin && isTerminal(os.Stdin) {
+		p.terminalUI = config.TerminalUI
+		p.keyMode = cbreakStdin
+	}
+}
+
+// ValidateHumanHint reports a hint strategy that is not registered, or that is played by a person
+func (config GameConfig) ValidateHumanHint() error {
+	if config.HumanHint == "" || slices.Contains(GetRegisteredAutomatedPlayerStrategyNames(), config.HumanHint) {
+		return nil
+	}
+
+	return fmt.Errorf("unknown hint strategy %q, expected one of %s", config.HumanHint, strings.Join(GetRegisteredAutomatedPlayerStrategyNames(), ", "))
+}
+
+func (p PlayerStrategyHuman) GetName() string {
+	return "Human Player"
+}
+
+func (p PlayerStrategyHuman) GetPlayerValue() int {
+	return p.playerValue
+}
+
+// PlayerChoosesAMove shows the board and prompts until the player types a column that can be played or a command
+// The player resigns when the input runs out.
+func (p PlayerStrategyHuman) PlayerChoosesAMove(gameBoard BoardView) int {
+	if p.terminalUI != nil {
+		if column, ok := p.chooseWithCursor(gameBoard); ok {
+			return column
+		}
+	}
+
+	fmt.Fprintln(p.output)
+	renderer := p.renderer
+	if renderer == nil {
+		renderer = ASCIIRenderer{}
+	}
+	renderer.Render(p.output, gameBoard.Snapshot())
+
+	for {
+		fmt.Fprintf(p.output, "Player %d, choose a column 1-%d, or hint, undo or resign: ", p.playerValue, gameBoard.GetWidth())
+		line, err := p.input.ReadString('\n')
+		if err != nil && line == "" {
+			fmt.Fprintln(p.output)
+			fmt.Fprintln(p.output, "No more input, Player", p.playerValue, "resigns")
+			return StatusResign
+		}
+
+		command := strings.ToLower(strings.TrimSpace(line))
+		switch command {
+		case "":
+			continue
+		case "resign":
+			return StatusResign
+		case "hint":
+			p.printHint(gameBoard)
+			continue
+		case "undo":
+			if len(gameBoard.GetTurnHistory()) < NumPlayers {
+				fmt.Fprintln(p.output, "There is no move of yours to take back")
+				continue
+			}
+			return StatusUndoRequest
+		}
+
+		column, err := strconv.Atoi(command)
+		switch {
+		case err != nil:
+			fmt.Fprintf(p.output, "%q is not a column or a command\n", command)
+		case column < 1 || column > gameBoard.GetWidth():
+			fmt.Fprintf(p.output, "Column %d is not between 1 and %d\n", column, gameBoard.GetWidth())
+		case gameBoard.AvailableRow(column-1) == StatusRowIsFull:
+			fmt.Fprintf(p.output, "Column %d is full\n", column)
+		default:
+			return column - 1
+		}
+	}
+}
+
+// chooseWithCursor has the player choose on the TerminalUI, it is not ok when the terminal cannot read single keys
+func (p PlayerStrategyHuman) chooseWithCursor(gameBoard BoardView) (int, bool) {
+	if p.keyMode != nil {
+		restore, err := p.keyMode()
+		if err != nil {
+			return 0, false
+		}
+		defer restore()
+	}
+
+	cursor := firstAvailableColumn(gameBoard)
+	message := fmt.Sprintf("Player %d: move with the arrow keys, enter drops, ? hint, u undo, q resign", p.playerValue)
+	for {
+		column, command := p.terminalUI.chooseColumn(gameBoard, p.input, cursor, message)
+		switch command {
+		case 0:
+			return column, true
+		case 'q':
+			return StatusResign, true
+		case '?':
+			message = p.hintMessage(gameBoard)
+		case 'u':
+			if len(gameBoard.GetTurnHistory()) >= NumPlayers {
+				return StatusUndoRequest, true
+			}
+			message = "There is no move of yours to take back"
+		}
+		cursor = column
+	}
+}
+
+func (p PlayerStrategyHuman) printHint(gameBoard BoardView) {
+	fmt.Fprintln(p.output, p.hintMessage(gameBoard))
+}
+
+func (p PlayerStrategyHuman) hintMessage(gameBoard BoardView) string {
+	hint := p.hint
+	if hint == "" {
+		hint = DefaultHumanHintStrategy
+	}
+
+	if isInteractive(hint) {
+		return "No hint is available from another human player"
+	}
+
+	rng := p.rng
+	if rng == nil {
+		rng = newRandomlySeededRand()
+	}
+	engine := CreateSeededPlayerStrategy(hint, p.playerValue, rng)
+
+	column := engine.PlayerChoosesAMove(gameBoard)
+	if column < 0 {
+		return "No hint is available, there is no column to play"
+	}
+
+	return fmt.Sprintf("Hint: %s plays column %d", engine.GetName(), column+1)
+}
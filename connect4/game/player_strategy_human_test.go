@@ -0,0 +1,191 @@
+package game
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func init() {
+	RegisterInteractive("testhuman", func(playerValue int, rng *rand.Rand) PlayerStrategy {
+		return NewPlayerStrategyHumanWithIO(playerValue, strings.NewReader("4\n4\nundo\n1\nresign\n"), io.Discard, "firstavailable")
+	})
+	RegisterInteractive("testhumanundoes", func(playerValue int, rng *rand.Rand) PlayerStrategy {
+		return NewPlayerStrategyHumanWithIO(playerValue, strings.NewReader("4\n4\n"+strings.Repeat("undo\n4\n", MaxTakeBacks)+"undo\nresign\n"), io.Discard, "firstavailable")
+	})
+}
+
+func TestHumanRepromptsUntilAColumnCanBePlayed(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("444444")
+	if err != nil {
+		t.Fatalf(`TestHumanRepromptsUntilAColumnCanBePlayed returned error %v`, err)
+	}
+
+	var output bytes.Buffer
+	player := NewPlayerStrategyHumanWithIO(1, strings.NewReader("abc\n\n0\n9\n4\n5\n"), &output, "firstavailable")
+	chosenColumn := player.PlayerChoosesAMove(NewBoardView(gameBoard, 1, 2))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestHumanRepromptsUntilAColumnCanBePlayed expected column 4 but got %d`, chosenColumn)
+	}
+	for _, expected := range []string{`"abc" is not a column`, "Column 0 is not between 1 and 7", "Column 9 is not between 1 and 7", "Column 4 is full", "|  _  _  _  2  _  _  _  |"} {
+		if !strings.Contains(output.String(), expected) {
+			t.Errorf(`TestHumanRepromptsUntilAColumnCanBePlayed expected %q in the output %v`, expected, output.String())
+		}
+	}
+}
+
+func TestHumanResigns(t *testing.T) {
+	for _, input := range []string{"resign\n", "Resign\n", ""} {
+		player := NewPlayerStrategyHumanWithIO(1, strings.NewReader(input), io.Discard, "firstavailable")
+
+		if chosenColumn := player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2)); chosenColumn != StatusResign {
+			t.Errorf(`TestHumanResigns expected %q to resign but got %d`, input, chosenColumn)
+		}
+	}
+}
+
+func TestHumanUndoNeedsAMoveToTakeBack(t *testing.T) {
+	var output bytes.Buffer
+	player := NewPlayerStrategyHumanWithIO(1, strings.NewReader("undo\n1\n"), &output, "firstavailable")
+
+	if chosenColumn := player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2)); chosenColumn != 0 {
+		t.Errorf(`TestHumanUndoNeedsAMoveToTakeBack expected column 0 but got %d`, chosenColumn)
+	}
+	if !strings.Contains(output.String(), "There is no move of yours to take back") {
+		t.Errorf(`TestHumanUndoNeedsAMoveToTakeBack expected the undo to be refused in %v`, output.String())
+	}
+
+	gameBoard, err := NewGameBoardFromMoves("44")
+	if err != nil {
+		t.Fatalf(`TestHumanUndoNeedsAMoveToTakeBack returned error %v`, err)
+	}
+	player = NewPlayerStrategyHumanWithIO(1, strings.NewReader("undo\n"), io.Discard, "firstavailable")
+	if chosenColumn := player.PlayerChoosesAMove(NewBoardView(gameBoard, 1, 2)); chosenColumn != StatusUndoRequest {
+		t.Errorf(`TestHumanUndoNeedsAMoveToTakeBack expected an undo request but got %d`, chosenColumn)
+	}
+}
+
+func TestHumanAsksForAHint(t *testing.T) {
+	var output bytes.Buffer
+	player := NewPlayerStrategyHumanWithIO(1, strings.NewReader("hint\n4\n"), &output, "firstavailable")
+	player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2))
+
+	if !strings.Contains(output.String(), "Hint: First Available Move Strategy plays column 4") {
+		t.Errorf(`TestHumanAsksForAHint expected a hint in %v`, output.String())
+	}
+}
+
+func TestHumanHintsAreSeededByTheGame(t *testing.T) {
+	gameBoard := NewBoardView(NewGameBoard(), 1, 2)
+	hints := func(seed int64) []string {
+		player := &PlayerStrategyHuman{playerValue: 1, hint: "random", rng: rand.New(rand.NewSource(seed))}
+		return []string{player.hintMessage(gameBoard), player.hintMessage(gameBoard), player.hintMessage(gameBoard)}
+	}
+
+	if first, second := hints(7), hints(7); !slices.Equal(first, second) {
+		t.Errorf(`TestHumanHintsAreSeededByTheGame expected the same hints from the same seed but got %q and %q`, first, second)
+	}
+
+	player := &PlayerStrategyHuman{playerValue: 1, hint: "testhuman"}
+	if hint := player.hintMessage(gameBoard); hint != "No hint is available from another human player" {
+		t.Errorf(`TestHumanHintsAreSeededByTheGame expected no hint from an interactive strategy but got %q`, hint)
+	}
+}
+
+func TestHumanTakesTheHintFromTheGameConfig(t *testing.T) {
+	var output bytes.Buffer
+	player := NewPlayerStrategyHumanWithIO(1, strings.NewReader("hint\n4\n"), &output, "")
+	config := NewDefaultGameConfig()
+	config.HumanHint = "firstavailable"
+	player.(gameConfigured).useGameConfig(config)
+	player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2))
+
+	if !strings.Contains(output.String(), "Hint: First Available Move Strategy plays column 4") {
+		t.Errorf(`TestHumanTakesTheHintFromTheGameConfig expected a hint from the config in %v`, output.String())
+	}
+}
+
+func TestHumanDrawsWithTheGameConfigRenderer(t *testing.T) {
+	var output, screen bytes.Buffer
+	player := NewPlayerStrategyHumanWithIO(1, strings.NewReader("4\n"), &output, "firstavailable")
+	config := NewDefaultGameConfig()
+	config.Renderer = CompactRenderer{}
+	config.TerminalUI = NewTerminalUI(&screen, 0)
+	player.(gameConfigured).useGameConfig(config)
+
+	if chosenColumn := player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2)); chosenColumn != 3 {
+		t.Errorf(`TestHumanDrawsWithTheGameConfigRenderer expected column 3 but got %d`, chosenColumn)
+	}
+	if !strings.Contains(output.String(), "......./......./") {
+		t.Errorf(`TestHumanDrawsWithTheGameConfigRenderer expected the compact board in %v`, output.String())
+	}
+	if screen.Len() != 0 {
+		t.Errorf(`TestHumanDrawsWithTheGameConfigRenderer expected a player not at the terminal to leave the terminal UI alone`)
+	}
+}
+
+func TestValidateHumanHint(t *testing.T) {
+	config := NewDefaultGameConfig()
+	for hint, valid := range map[string]bool{"negamax": true, "": true, "negmax": false, "human": false} {
+		config.HumanHint = hint
+		if err := config.ValidateHumanHint(); (err == nil) != valid {
+			t.Errorf(`TestValidateHumanHint expected %q to be valid %v but got %v`, hint, valid, err)
+		}
+	}
+}
+
+func TestHumanGameWithUndoAndResign(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "testhuman"
+	config.Player2 = "firstavailable"
+	config.Output = nil
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndResign || result.Winner != 2 || result.EndedBy != 1 || result.Turn != 4 {
+		t.Fatalf(`TestHumanGameWithUndoAndResign expected player 1 to resign on turn 4 but got %v`, result.Message())
+	}
+
+	columns := []int{}
+	for _, turn := range result.Turns {
+		columns = append(columns, turn.Column)
+	}
+	if len(columns) != 4 || columns[2] != 0 || len(result.ThinkTimes) != 4 {
+		t.Errorf(`TestHumanGameWithUndoAndResign expected the second move to be taken back but got columns %v`, columns)
+	}
+	if !strings.Contains(result.Message(), "Player 1 Human Player resigns") {
+		t.Errorf(`TestHumanGameWithUndoAndResign message: %v`, result.Message())
+	}
+}
+
+func TestHumanTakeBacksAreLimited(t *testing.T) {
+	var output bytes.Buffer
+	config := NewDefaultGameConfig()
+	config.Player1 = "testhumanundoes"
+	config.Player2 = "firstavailable"
+	config.Output = &output
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndResign || result.Turn != 4 {
+		t.Fatalf(`TestHumanTakeBacksAreLimited expected player 1 to resign on turn 4 but got %v`, result.Message())
+	}
+	if count := strings.Count(output.String(), "takes back their last move"); count != MaxTakeBacks {
+		t.Errorf(`TestHumanTakeBacksAreLimited expected %d take-backs but got %d`, MaxTakeBacks, count)
+	}
+	if !strings.Contains(output.String(), "has no take-backs left") {
+		t.Errorf(`TestHumanTakeBacksAreLimited expected the last undo to be refused in %v`, output.String())
+	}
+}
+
+func TestHumanIsNotAnAutomatedStrategy(t *testing.T) {
+	for _, name := range GetRegisteredAutomatedPlayerStrategyNames() {
+		if name == "human" {
+			t.Errorf(`TestHumanIsNotAnAutomatedStrategy expected human to be left out of %v`, GetRegisteredAutomatedPlayerStrategyNames())
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+func init() {
+	RegisterSeeded("mcts", NewSeededPlayerStrategyMCTS)
+	RegisterSeeded("mctsblocker", NewSeededPlayerStrategyMCTSWithBlockerPlayouts)
+}
+
+type MCTSOptions struct {
+	Playouts      int           // the number of playouts per move
+	Exploration   float64       // the UCT exploration constant
+	TimeBudget    time.Duration // stop early once this much time has passed, zero means no limit
+	PlayoutPolicy string        // the registered PlayerStrategy that plays out each simulated game
+}
+
+func NewDefaultMCTSOptions() MCTSOptions {
+	return MCTSOptions{
+		Playouts:      2000,
+		Exploration:   math.Sqrt2,
+		PlayoutPolicy: "random",
+	}
+}
+
+type PlayerStrategyMCTS struct {
+	playerValue int
+	options     MCTSOptions
+	rng         *rand.Rand // shared with the playout policy
+}
+
+func NewPlayerStrategyMCTS(playerValue int) PlayerStrategy {
+	return NewSeededPlayerStrategyMCTS(playerValue, newRandomlySeededRand())
+}
+
+func NewSeededPlayerStrategyMCTS(playerValue int, rng *rand.Rand) PlayerStrategy {
+	return NewSeededPlayerStrategyMCTSWithOptions(playerValue, NewDefaultMCTSOptions(), rng)
+}
+
+func NewPlayerStrategyMCTSWithBlockerPlayouts(playerValue int) PlayerStrategy {
+	return NewSeededPlayerStrategyMCTSWithBlockerPlayouts(playerValue, newRandomlySeededRand())
+}
+
+func NewSeededPlayerStrategyMCTSWithBlockerPlayouts(playerValue int, rng *rand.Rand) PlayerStrategy {
+	options := NewDefaultMCTSOptions()
+	options.PlayoutPolicy = "blocker"
+
+	return NewSeededPlayerStrategyMCTSWithOptions(playerValue, options, rng)
+}
+
+func NewPlayerStrategyMCTSWithOptions(playerValue int, options MCTSOptions) PlayerStrategy {
+	return NewSeededPlayerStrategyMCTSWithOptions(playerValue, options, newRandomlySeededRand())
+}
+
+func NewSeededPlayerStrategyMCTSWithOptions(playerValue int, options MCTSOptions, rng *rand.Rand) PlayerStrategy {
+	return &PlayerStrategyMCTS{
+		playerValue: playerValue,
+		options:     options,
+		rng:         rng,
+	}
+}
+
+func (p PlayerStrategyMCTS) GetName() string {
+	return fmt.Sprintf("Monte Carlo Tree Search Strategy (%s playouts)", p.options.PlayoutPolicy)
+}
+
+func (p PlayerStrategyMCTS) GetPlayerValue() int {
+	return p.playerValue
+}
+
+func (p PlayerStrategyMCTS) PlayerChoosesAMove(gameBoard BoardView) int {
+	return p.PlayerChoosesAMoveWithContext(context.Background(), gameBoard)
+}
+
+// PlayerChoosesAMoveWithContext stops running playouts once ctx is done and plays the most visited move so far
+func (p PlayerStrategyMCTS) PlayerChoosesAMoveWithContext(ctx context.Context, gameBoard BoardView) int {
+	playerValues := [NumPlayers]int{p.playerValue, gameBoard.OpponentValue()}
+
+	rootBoard, err := newBitBoardFromActions(gameBoard, playerValues)
+	if err != nil {
+		// boards too large for a bitboard are not searched
+		return NewPlayerStrategyFirstAvailableMove(p.playerValue).PlayerChoosesAMove(gameBoard)
+	}
+
+	search := mctsSearch{
+		options:      p.options,
+		rng:          p.rng,
+		rootBoard:    rootBoard,
+		playoutHands: [NumPlayers]PlayerStrategy{p.playoutPolicy(playerValues[0]), p.playoutPolicy(playerValues[1])},
+	}
+
+	return search.bestColumn(ctx)
+}
+
+func (p PlayerStrategyMCTS) playoutPolicy(playerValue int) PlayerStrategy {
+	// a person would be asked for every move of every playout
+	if isInteractive(p.options.PlayoutPolicy) {
+		return NewSeededPlayerStrategyRandom(playerValue, p.rng)
+	}
+
+	policy := GetRegisteredSeededPlayerStrategy(p.options.PlayoutPolicy, playerValue, p.rng)
+
+	// a tree search inside every playout would never finish
+	if _, isTreeSearch := policy.(*PlayerStrategyMCTS); policy == nil || isTreeSearch {
+		policy = NewSeededPlayerStrategyRandom(playerValue, p.rng)
+	}
+
+	return policy
+}
+
+type mctsNode struct {
+	parent    *mctsNode
+	column    int // the move that led to this node
+	playerNdx int // the player who made that move
+	children  []*mctsNode
+	untried   []int
+	terminal  bool
+	visits    int
+	wins      float64 // from the point of view of playerNdx, a draw is half a win
+}
+
+func newMCTSNode(parent *mctsNode, column int, playerNdx int, board *BitBoard) *mctsNode {
+	node := &mctsNode{parent: parent, column: column, playerNdx: playerNdx}
+
+	node.terminal = (parent != nil && board.hasConnection(board.pieces[playerNdx])) || board.isFull()
+	if !node.terminal {
+		node.untried = board.legalColumns()
+	}
+
+	return node
+}
+
+func (node *mctsNode) uctChild(exploration float64) *mctsNode {
+	var best *mctsNode
+	bestValue := math.Inf(-1)
+	logVisits := math.Log(float64(node.visits))
+
+	for _, child := range node.children {
+		value := child.wins/float64(child.visits) + exploration*math.Sqrt(logVisits/float64(child.visits))
+		if value > bestValue {
+			bestValue = value
+			best = child
+		}
+	}
+
+	return best
+}
+
+type mctsSearch struct {
+	options      MCTSOptions
+	rng          *rand.Rand
+	rootBoard    *BitBoard
+	playoutHands [NumPlayers]PlayerStrategy
+}
+
+func (s *mctsSearch) bestColumn(ctx context.Context) int {
+	// the strategy is always the first player of its own bitboard, so the root move was the opponent's
+	root := newMCTSNode(nil, NoPlayer, 1, s.rootBoard)
+	if len(root.untried) == 0 {
+		return StatusNoAvailableMove
+	}
+
+	deadline := time.Time{}
+	if s.options.TimeBudget > 0 {
+		deadline = time.Now().Add(s.options.TimeBudget)
+	}
+
+	for playout := 0; playout < max(1, s.options.Playouts); playout++ {
+		if (!deadline.IsZero() && time.Now().After(deadline)) || ctx.Err() != nil {
+			break
+		}
+
+		board := s.rootBoard.copyBitBoard()
+		node := root
+
+		// selection
+		for len(node.untried) == 0 && len(node.children) > 0 {
+			node = node.uctChild(s.options.Exploration)
+			board.play(node.playerNdx, node.column)
+		}
+
+		// expansion
+		if len(node.untried) > 0 {
+			ndx := s.rng.Intn(len(node.untried))
+			column := node.untried[ndx]
+			node.untried = append(node.untried[:ndx], node.untried[ndx+1:]...)
+
+			board.play(1-node.playerNdx, column)
+			child := newMCTSNode(node, column, 1-node.playerNdx, board)
+			node.children = append(node.children, child)
+			node = child
+		}
+
+		// simulation
+		winnerNdx := NoPlayer
+		if node.terminal {
+			if board.hasConnection(board.pieces[node.playerNdx]) {
+				winnerNdx = node.playerNdx
+			}
+		} else {
+			winnerNdx = s.playout(board, 1-node.playerNdx)
+		}
+
+		// backpropagation
+		for ; node != nil; node = node.parent {
+			node.visits++
+			if winnerNdx == node.playerNdx {
+				node.wins++
+			} else if winnerNdx == NoPlayer {
+				node.wins += 0.5
+			}
+		}
+	}
+
+	// without any playouts the center-most legal column is as good a guess as any
+	bestColumn := StatusNoAvailableMove
+	for _, column := range CenterFirstColumnOrder(s.rootBoard.width) {
+		if s.rootBoard.AvailableRow(column) != StatusRowIsFull {
+			bestColumn = column
+			break
+		}
+	}
+
+	bestVisits := 0
+	for _, child := range root.children {
+		if child.visits > bestVisits {
+			bestVisits = child.visits
+			bestColumn = child.column
+		}
+	}
+
+	return bestColumn
+}
+
+// playout finishes the game with the playout policy and returns the index of the winner or NoPlayer for a draw
+func (s *mctsSearch) playout(board *BitBoard, playerNdx int) int {
+	for !board.isFull() {
+		column := s.playoutHands[playerNdx].PlayerChoosesAMove(NewBoardView(board, board.playerValues[playerNdx], board.playerValues[1-playerNdx]))
+		if column < 0 || column >= board.width || board.AvailableRow(column) == StatusRowIsFull {
+			legalColumns := board.legalColumns()
+			column = legalColumns[s.rng.Intn(len(legalColumns))]
+		}
+
+		board.play(playerNdx, column)
+		if board.hasConnection(board.pieces[playerNdx]) {
+			return playerNdx
+		}
+
+		playerNdx = 1 - playerNdx
+	}
+
+	return NoPlayer
+}
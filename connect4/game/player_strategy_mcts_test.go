@@ -0,0 +1,113 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMCTSTakesImmediateWin(t *testing.T) {
+	me := 1
+	player := NewPlayerStrategyMCTS(me)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 2, -1, -1, -1},
+		{-1, -1, -1, 2, -1, -1, -1},
+		{2, me, me, me, -1, -1, -1},
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestMCTSTakesImmediateWin expected column 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestMCTSBlocksImmediateLoss(t *testing.T) {
+	me := 2
+	player := NewPlayerStrategyMCTS(me)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, 1, -1, -1},
+		{-1, -1, -1, me, 1, -1, -1},
+		{-1, -1, me, me, 1, -1, -1},
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestMCTSBlocksImmediateLoss expected column 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestMCTSWithBlockerPlayoutsUsesBlockerPolicy(t *testing.T) {
+	player := NewPlayerStrategyMCTSWithBlockerPlayouts(1)
+
+	if player.GetName() != "Monte Carlo Tree Search Strategy (blocker playouts)" {
+		t.Errorf(`TestMCTSWithBlockerPlayoutsUsesBlockerPolicy created %v`, player.GetName())
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewGameBoard(), player.GetPlayerValue()))
+	if chosenColumn < 0 || chosenColumn >= BoardWidth {
+		t.Errorf(`TestMCTSWithBlockerPlayoutsUsesBlockerPolicy expected a column within 0 - %v but played in %v column`, BoardWidth, chosenColumn)
+	}
+}
+
+func TestMCTSUnknownPlayoutPolicyFallsBackToRandom(t *testing.T) {
+	options := NewDefaultMCTSOptions()
+	options.PlayoutPolicy = "mcts"
+	player := PlayerStrategyMCTS{playerValue: 1, options: options}
+
+	if _, isRandom := player.playoutPolicy(1).(*PlayerStrategyRandom); !isRandom {
+		t.Errorf(`TestMCTSUnknownPlayoutPolicyFallsBackToRandom expected a random playout policy`)
+	}
+}
+
+func TestMCTSInteractivePlayoutPolicyFallsBackToRandom(t *testing.T) {
+	options := NewDefaultMCTSOptions()
+	options.PlayoutPolicy = "human"
+	player := PlayerStrategyMCTS{playerValue: 1, options: options}
+
+	if _, isRandom := player.playoutPolicy(1).(*PlayerStrategyRandom); !isRandom {
+		t.Errorf(`TestMCTSInteractivePlayoutPolicyFallsBackToRandom expected a random playout policy`)
+	}
+}
+
+func TestMCTSStopsAtTimeBudget(t *testing.T) {
+	options := NewDefaultMCTSOptions()
+	options.Playouts = 1_000_000_000
+	options.TimeBudget = 50 * time.Millisecond
+	player := NewPlayerStrategyMCTSWithOptions(1, options)
+
+	start := time.Now()
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewGameBoard(), player.GetPlayerValue()))
+	elapsed := time.Since(start)
+
+	if elapsed > time.Second {
+		t.Errorf(`TestMCTSStopsAtTimeBudget expected to stop after about 50ms but took %v`, elapsed)
+	}
+	if chosenColumn < 0 || chosenColumn >= BoardWidth {
+		t.Errorf(`TestMCTSStopsAtTimeBudget expected a column within 0 - %v but played in %v column`, BoardWidth, chosenColumn)
+	}
+}
+
+func TestMCTSOnFullBoard(t *testing.T) {
+	player := NewPlayerStrategyMCTS(1)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{1, 1, 2, 1, 2, 1, 2},
+		{2, 1, 2, 1, 2, 1, 2},
+		{1, 2, 1, 2, 1, 2, 1},
+		{1, 2, 1, 2, 1, 2, 1},
+		{2, 1, 2, 1, 2, 1, 2},
+		{2, 1, 2, 1, 2, 1, 2},
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != StatusNoAvailableMove {
+		t.Errorf(`TestMCTSOnFullBoard expected StatusNoAvailableMove but played in %v column`, chosenColumn)
+	}
+}
@@ -0,0 +1,382 @@
+package game
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+func init() {
+	Register("negamax", NewPlayerStrategyNegamax)
+}
+
+const NegamaxDefaultDepth int = 8
+
+// negamaxWinScore is larger than any heuristic evaluation, wins found sooner score higher
+const negamaxWinScore int = 1_000_000
+
+// negamaxWinThreshold separates the scores of wins and losses, negamaxWinScore less the ply, from heuristic evaluations
+const negamaxWinThreshold int = negamaxWinScore / 2
+
+const negamaxCenterBonus int = 3
+
+// negamaxMaxTranspositions bounds the transposition table kept between moves
+const negamaxMaxTranspositions int = 1 << 20
+
+const (
+	transpositionExact = iota
+	transpositionLowerBound
+	transpositionUpperBound
+)
+
+type transposition struct {
+	depth      int
+	score      int
+	bound      int
+	bestColumn int
+}
+
+// zobristKeys holds one random key per space per player, the hash of a position is the xor of its pieces
+type zobristKeys struct {
+	width  int
+	height int
+	spaces [][NumPlayers]uint64
+	toMove [NumPlayers]uint64
+}
+
+func newZobristKeys(width int, height int) *zobristKeys {
+	// a fixed seed keeps searches reproducible
+	rng := rand.New(rand.NewSource(0x5eed))
+
+	keys := &zobristKeys{width: width, height: height, spaces: make([][NumPlayers]uint64, width*height)}
+	for ndx := range keys.spaces {
+		for playerNdx := range NumPlayers {
+			keys.spaces[ndx][playerNdx] = rng.Uint64()
+		}
+	}
+	for playerNdx := range NumPlayers {
+		keys.toMove[playerNdx] = rng.Uint64()
+	}
+
+	return keys
+}
+
+func (keys *zobristKeys) space(column int, row int, playerNdx int) uint64 {
+	return keys.spaces[column*keys.height+row][playerNdx]
+}
+
+type PlayerStrategyNegamax struct {
+	playerValue    int
+	depth          int
+	zobrist        *zobristKeys
+	transpositions map[uint64]transposition
+}
+
+func NewPlayerStrategyNegamax(playerValue int) PlayerStrategy {
+	return NewPlayerStrategyNegamaxWithDepth(playerValue, NegamaxDefaultDepth)
+}
+
+// NewPlayerStrategyNegamaxWithDepth searches depth moves ahead, counting both players' moves
+func NewPlayerStrategyNegamaxWithDepth(playerValue int, depth int) PlayerStrategy {
+	return &PlayerStrategyNegamax{
+		playerValue:    playerValue,
+		depth:          max(1, depth),
+		transpositions: make(map[uint64]transposition),
+	}
+}
+
+func (p PlayerStrategyNegamax) GetName() string {
+	return "Negamax Strategy"
+}
+
+func (p PlayerStrategyNegamax) GetPlayerValue() int {
+	return p.playerValue
+}
+
+func (p *PlayerStrategyNegamax) PlayerChoosesAMove(gameBoard BoardView) int {
+	return p.PlayerChoosesAMoveWithContext(context.Background(), gameBoard)
+}
+
+// PlayerChoosesAMoveWithContext deepens the search one move at a time and plays the choice of the deepest
+// search that finished before ctx was done
+func (p *PlayerStrategyNegamax) PlayerChoosesAMoveWithContext(ctx context.Context, gameBoard BoardView) int {
+	bitBoard, err := newBitBoardFromActions(gameBoard, [NumPlayers]int{p.playerValue, gameBoard.OpponentValue()})
+	if err != nil {
+		// boards too large for a bitboard are not searched
+		return NewPlayerStrategyFirstAvailableMove(p.playerValue).PlayerChoosesAMove(gameBoard)
+	}
+
+	if p.zobrist == nil || p.zobrist.width != bitBoard.width || p.zobrist.height != bitBoard.height {
+		p.zobrist = newZobristKeys(bitBoard.width, bitBoard.height)
+		p.transpositions = make(map[uint64]transposition)
+	}
+	if len(p.transpositions) > negamaxMaxTranspositions {
+		p.transpositions = make(map[uint64]transposition)
+	}
+
+	search := negamaxSearch{
+		ctx:            ctx,
+		board:          bitBoard,
+		zobrist:        p.zobrist,
+		transpositions: p.transpositions,
+		windows:        winningWindows(bitBoard),
+		centerMask:     centerColumnMask(bitBoard),
+		columnOrder:    CenterFirstColumnOrder(bitBoard.width),
+	}
+	search.hash = search.hashBoard()
+
+	// a search that is cut short always has the shallower answer to fall back on
+	bestColumn := CenterFirstColumnOrder(bitBoard.width)[0]
+	for depth := 1; depth <= p.depth; depth++ {
+		column := search.bestColumn(depth)
+		if search.cancelled {
+			break
+		}
+		bestColumn = column
+	}
+
+	if bestColumn == StatusNoAvailableMove || gameBoard.AvailableRow(bestColumn) == StatusRowIsFull {
+		return NewPlayerStrategyFirstAvailableMove(p.playerValue).PlayerChoosesAMove(gameBoard)
+	}
+
+	return bestColumn
+}
+
+// negamaxNodesBetweenCancelChecks keeps the cost of checking the context small
+const negamaxNodesBetweenCancelChecks int = 1024
+
+type negamaxSearch struct {
+	ctx            context.Context
+	cancelled      bool
+	nodes          int
+	board          *BitBoard
+	zobrist        *zobristKeys
+	transpositions map[uint64]transposition
+	windows        []uint64
+	centerMask     uint64
+	columnOrder    []int
+	hash           uint64
+}
+
+func (s *negamaxSearch) hashBoard() uint64 {
+	hash := uint64(0)
+
+	for column := range s.board.width {
+		for row := range s.board.height {
+			cell := s.board.cellBit(column, row)
+			for playerNdx, pieces := range s.board.pieces {
+				if pieces&cell != 0 {
+					hash ^= s.zobrist.space(column, row, playerNdx)
+				}
+			}
+		}
+	}
+
+	return hash
+}
+
+func (s *negamaxSearch) play(playerNdx int, column int) {
+	row := s.board.AvailableRow(column)
+	s.board.play(playerNdx, column)
+	s.hash ^= s.zobrist.space(column, row, playerNdx)
+}
+
+func (s *negamaxSearch) undo(playerNdx int, column int) {
+	s.board.undo(playerNdx, column)
+	s.hash ^= s.zobrist.space(column, s.board.AvailableRow(column), playerNdx)
+}
+
+// orderedColumns puts the remembered best column first, then the rest center first
+func (s *negamaxSearch) orderedColumns(firstColumn int) []int {
+	columns := make([]int, 0, len(s.columnOrder))
+	if firstColumn >= 0 && s.board.AvailableRow(firstColumn) != StatusRowIsFull {
+		columns = append(columns, firstColumn)
+	}
+
+	for _, column := range s.columnOrder {
+		if column != firstColumn && s.board.AvailableRow(column) != StatusRowIsFull {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+func (s *negamaxSearch) bestColumn(depth int) int {
+	// the strategy is always the first player of its own bitboard
+	bestColumn := StatusNoAvailableMove
+	bestScore := math.MinInt
+	alpha := -negamaxWinScore - 1
+	beta := negamaxWinScore + 1
+
+	for _, column := range s.orderedColumns(NoPlayer) {
+		score := s.scoreMove(0, column, depth, alpha, beta, 0)
+
+		if score > bestScore {
+			bestScore = score
+			bestColumn = column
+		}
+		alpha = max(alpha, score)
+	}
+
+	return bestColumn
+}
+
+// scoreMove plays column for playerNdx and scores the result from playerNdx's point of view
+func (s *negamaxSearch) scoreMove(playerNdx int, column int, depth int, alpha int, beta int, ply int) int {
+	s.play(playerNdx, column)
+	defer s.undo(playerNdx, column)
+
+	if s.board.hasConnection(s.board.pieces[playerNdx]) {
+		return negamaxWinScore - ply
+	}
+
+	return -s.negamax(1-playerNdx, depth-1, -beta, -alpha, ply+1)
+}
+
+func (s *negamaxSearch) negamax(playerNdx int, depth int, alpha int, beta int, ply int) int {
+	s.nodes++
+	if s.nodes%negamaxNodesBetweenCancelChecks == 0 && s.ctx.Err() != nil {
+		s.cancelled = true
+	}
+	if s.cancelled {
+		// the result is thrown away, unwind as fast as possible
+		return 0
+	}
+
+	if s.board.isFull() {
+		return 0
+	}
+
+	if depth <= 0 {
+		return s.evaluate(playerNdx)
+	}
+
+	key := s.hash ^ s.zobrist.toMove[playerNdx]
+	originalAlpha := alpha
+	rememberedColumn := NoPlayer
+
+	if entry, exists := s.transpositions[key]; exists {
+		rememberedColumn = entry.bestColumn
+		if entry.depth >= depth {
+			score := scoreFromTransposition(entry.score, ply)
+			switch entry.bound {
+			case transpositionExact:
+				return score
+			case transpositionLowerBound:
+				alpha = max(alpha, score)
+			case transpositionUpperBound:
+				beta = min(beta, score)
+			}
+			if alpha >= beta {
+				return score
+			}
+		}
+	}
+
+	bestScore := math.MinInt
+	bestColumn := NoPlayer
+
+	for _, column := range s.orderedColumns(rememberedColumn) {
+		score := s.scoreMove(playerNdx, column, depth, alpha, beta, ply)
+
+		if score > bestScore {
+			bestScore = score
+			bestColumn = column
+		}
+
+		alpha = max(alpha, score)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	if s.cancelled {
+		return 0
+	}
+
+	bound := transpositionExact
+	if bestScore <= originalAlpha {
+		bound = transpositionUpperBound
+	} else if bestScore >= beta {
+		bound = transpositionLowerBound
+	}
+	s.transpositions[key] = transposition{depth: depth, score: scoreToTransposition(bestScore, ply), bound: bound, bestColumn: bestColumn}
+
+	return bestScore
+}
+
+// scoreToTransposition counts the plies of a win or loss from the node rather than the root,
+// so the entry is right wherever the position is met again, in this search or a later move's
+func scoreToTransposition(score int, ply int) int {
+	switch {
+	case score > negamaxWinThreshold:
+		return score + ply
+	case score < -negamaxWinThreshold:
+		return score - ply
+	default:
+		return score
+	}
+}
+
+// scoreFromTransposition is the score of a remembered node met at ply, see scoreToTransposition
+func scoreFromTransposition(score int, ply int) int {
+	return scoreToTransposition(score, -ply)
+}
+
+// evaluate counts the windows each player could still complete, weighting fuller windows more heavily
+// Pieces in the center column take part in the most windows and get a bonus
+func (s *negamaxSearch) evaluate(playerNdx int) int {
+	mine := s.board.pieces[playerNdx]
+	theirs := s.board.pieces[1-playerNdx]
+	score := negamaxCenterBonus * (bits.OnesCount64(mine&s.centerMask) - bits.OnesCount64(theirs&s.centerMask))
+
+	for _, window := range s.windows {
+		myCount := bits.OnesCount64(mine & window)
+		theirCount := bits.OnesCount64(theirs & window)
+
+		if theirCount == 0 {
+			score += myCount * myCount
+		} else if myCount == 0 {
+			score -= theirCount * theirCount
+		}
+	}
+
+	return score
+}
+
+func centerColumnMask(bitBoard *BitBoard) uint64 {
+	mask := uint64(0)
+	for row := range bitBoard.height {
+		mask |= bitBoard.cellBit(bitBoard.width/2, row)
+	}
+
+	return mask
+}
+
+// winningWindows lists a bit mask for every run of winningLength spaces on the board
+func winningWindows(bitBoard *BitBoard) []uint64 {
+	directions := [4][2]int{{1, 0}, {0, 1}, {1, 1}, {1, -1}}
+	windows := []uint64{}
+
+	for column := range bitBoard.width {
+		for row := range bitBoard.height {
+			for _, direction := range directions {
+				endColumn := column + direction[0]*(bitBoard.winningLength-1)
+				endRow := row + direction[1]*(bitBoard.winningLength-1)
+				if endColumn >= bitBoard.width || endRow < 0 || endRow >= bitBoard.height {
+					continue
+				}
+
+				window := uint64(0)
+				for step := range bitBoard.winningLength {
+					window |= bitBoard.cellBit(column+direction[0]*step, row+direction[1]*step)
+				}
+				windows = append(windows, window)
+			}
+		}
+	}
+
+	return windows
+}
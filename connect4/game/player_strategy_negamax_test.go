@@ -0,0 +1,123 @@
+package game
+
+import (
+	"testing"
+)
+
+func TestNegamaxEmptyBoardPlaysCenter(t *testing.T) {
+	player := NewPlayerStrategyNegamax(1)
+	gameBoard := NewGameBoard()
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
+
+	if chosenColumn != 3 {
+		t.Errorf(`TestNegamaxEmptyBoardPlaysCenter expected a column value of 3 but got %v column`, chosenColumn)
+	}
+}
+
+func TestNegamaxTakesImmediateWin(t *testing.T) {
+	me := 1
+	player := NewPlayerStrategyNegamaxWithDepth(me, 1)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, 2, 2, 2, -1, -1, -1},
+		{-1, me, me, me, -1, 2, -1},
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != 0 && chosenColumn != 4 {
+		t.Errorf(`TestNegamaxTakesImmediateWin expected column 0 or 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestNegamaxBlocksImmediateLoss(t *testing.T) {
+	me := 2
+	player := NewPlayerStrategyNegamax(me)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, 1, -1, -1},
+		{-1, -1, -1, me, 1, -1, -1},
+		{-1, -1, me, me, 1, -1, -1},
+	}
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestNegamaxBlocksImmediateLoss expected column 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestNegamaxSetsUpDoubleThreat(t *testing.T) {
+	me := 1
+	player := NewPlayerStrategyNegamaxWithDepth(me, 4)
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, 2, 2, -1, -1, -1},
+		{-1, -1, me, me, -1, -1, -1},
+	}
+
+	// playing column 4 leaves _ 1 1 1 _ on the bottom row, which can't be stopped
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(NewInProgressGameBoard(thisBoard), player.GetPlayerValue()))
+
+	if chosenColumn != 4 && chosenColumn != 1 {
+		t.Errorf(`TestNegamaxSetsUpDoubleThreat expected column 1 or 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestNegamaxFallsBackOnBoardsTooLargeToSearch(t *testing.T) {
+	player := NewPlayerStrategyNegamax(1)
+	gameBoard := NewGameBoardOfSize(9, 7, WinningLength)
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestNegamaxFallsBackOnBoardsTooLargeToSearch expected the center column 4 but got %v column`, chosenColumn)
+	}
+}
+
+func TestNegamaxBeatsBlockerAsEitherPlayer(t *testing.T) {
+	for _, negamaxPlays := range []string{"Player1", "Player2"} {
+		config := NewDefaultGameConfig()
+		config.Player1 = "blocker"
+		config.Player2 = "blocker"
+		expectedWinner := 1
+		if negamaxPlays == "Player1" {
+			config.Player1 = "negamax"
+		} else {
+			config.Player2 = "negamax"
+			expectedWinner = 2
+		}
+
+		result := PlayConnect4(config)
+		winner, message := result.Winner, result.Message()
+
+		if winner != expectedWinner {
+			t.Errorf(`TestNegamaxBeatsBlockerAsEitherPlayer negamax as %s: %v`, negamaxPlays, message)
+		}
+	}
+}
+
+func TestNegamaxTranspositionsKeepWinsRelativeToTheNode(t *testing.T) {
+	// a win three plies below a node found at ply 2 is five plies away at ply 2 and eight at ply 5
+	win := negamaxWinScore - 5
+	stored := scoreToTransposition(win, 2)
+
+	if score := scoreFromTransposition(stored, 5); score != negamaxWinScore-8 {
+		t.Errorf(`TestNegamaxTranspositionsKeepWinsRelativeToTheNode expected the win to score %d at ply 5 but got %d`, negamaxWinScore-8, score)
+	}
+	if score := scoreFromTransposition(scoreToTransposition(-win, 2), 5); score != -(negamaxWinScore - 8) {
+		t.Errorf(`TestNegamaxTranspositionsKeepWinsRelativeToTheNode expected the loss to score %d at ply 5 but got %d`, -(negamaxWinScore - 8), score)
+	}
+	if score := scoreFromTransposition(scoreToTransposition(42, 2), 5); score != 42 {
+		t.Errorf(`TestNegamaxTranspositionsKeepWinsRelativeToTheNode expected an evaluation to be unchanged but got %d`, score)
+	}
+}
@@ -5,15 +5,20 @@ import (
 )
 
 func init() {
-	Register("random", NewPlayerStrategyRandom)
+	RegisterSeeded("random", NewSeededPlayerStrategyRandom)
 }
 
 type PlayerStrategyRandom struct {
 	playerValue int
+	rng         *rand.Rand
 }
 
 func NewPlayerStrategyRandom(playerValue int) PlayerStrategy {
-	return &PlayerStrategyRandom{playerValue: playerValue}
+	return NewSeededPlayerStrategyRandom(playerValue, newRandomlySeededRand())
+}
+
+func NewSeededPlayerStrategyRandom(playerValue int, rng *rand.Rand) PlayerStrategy {
+	return &PlayerStrategyRandom{playerValue: playerValue, rng: rng}
 }
 
 func (p PlayerStrategyRandom) GetName() string {
@@ -24,7 +29,7 @@ func (p PlayerStrategyRandom) GetPlayerValue() int {
 	return p.playerValue
 }
 
-func (p PlayerStrategyRandom) PlayerChoosesAMove(gameBoard GameBoardActions) int {
-	column := rand.Intn(BoardWidth)
+func (p PlayerStrategyRandom) PlayerChoosesAMove(gameBoard BoardView) int {
+	column := p.rng.Intn(gameBoard.GetWidth())
 	return column
 }
@@ -1,6 +1,7 @@
 package game
 
 import (
+	"math/rand"
 	"testing"
 )
 
@@ -8,7 +9,7 @@ func TestRandomPlayerChoosesAMoveOnEmptyBoard(t *testing.T) {
 	player := NewPlayerStrategyRandom(1)
 	gameBoard := NewGameBoard()
 
-	chosenColumn := player.PlayerChoosesAMove(*gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	if chosenColumn < 0 || chosenColumn >= BoardWidth {
 		t.Errorf(`TestRandomPlayerChoosesAMoveOnEmptyBoard expected a column value within 0 - %v but played in %v column`, BoardWidth, chosenColumn)
@@ -20,7 +21,7 @@ func TestRandomPlayerChoosesAMoveOnFullBoard(t *testing.T) {
 	player := NewPlayerStrategyRandom(me)
 
 	thisBoard := [BoardHeight][BoardWidth]int{
-		{me, 22, me, 22, me, 22, me},
+		{22, 22, me, 22, me, 22, me},
 		{me, 22, me, 22, me, 22, me},
 		{22, me, 22, me, 22, me, 22},
 		{22, me, 22, me, 22, me, 22},
@@ -29,9 +30,23 @@ func TestRandomPlayerChoosesAMoveOnFullBoard(t *testing.T) {
 	}
 	gameBoard := NewInProgressGameBoard(thisBoard)
 
-	chosenColumn := player.PlayerChoosesAMove(gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	if chosenColumn < 0 || chosenColumn >= BoardWidth {
 		t.Errorf(`TestRandomPlayerChoosesAMoveOnEmptyBoard expected a column value within 0 - %v but played in %v column`, BoardWidth, chosenColumn)
 	}
 }
+
+func TestSeededRandomPlayerRepeatsItsMoves(t *testing.T) {
+	player := NewSeededPlayerStrategyRandom(1, rand.New(rand.NewSource(7)))
+	samePlayer := NewSeededPlayerStrategyRandom(1, rand.New(rand.NewSource(7)))
+	gameBoard := NewGameBoard()
+
+	for move := range 20 {
+		column := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
+		sameColumn := samePlayer.PlayerChoosesAMove(newTestBoardView(gameBoard, samePlayer.GetPlayerValue()))
+		if column != sameColumn {
+			t.Errorf(`TestSeededRandomPlayerRepeatsItsMoves move %d chose %d and %d from the same seed`, move, column, sameColumn)
+		}
+	}
+}
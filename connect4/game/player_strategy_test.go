@@ -1,6 +1,8 @@
 package game
 
 import (
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -9,7 +11,7 @@ func TestPlayerChoosesAMoveOnEmptyBoard(t *testing.T) {
 	gameBoard := NewGameBoard()
 	middleColumn := BoardWidth / 2
 
-	chosenColumn := player.PlayerChoosesAMove(*gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	if chosenColumn != middleColumn {
 		t.Errorf(`TestPlayerChoosesAMoveOnEmptyBoard expected %v column but played in %v column`, middleColumn, chosenColumn)
@@ -31,7 +33,7 @@ func TestPlayerChoosesAMoveOnFullMiddleRow(t *testing.T) {
 	gameBoard := NewInProgressGameBoard(thisBoard)
 	leftOfMiddleColumn := (BoardWidth / 2) - 1
 
-	chosenColumn := player.PlayerChoosesAMove(gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	if chosenColumn != leftOfMiddleColumn {
 		t.Errorf(`TestPlayerChoosesAMoveOnFullMiddleRow expected %v column but played in %v column`, leftOfMiddleColumn, chosenColumn)
@@ -42,7 +44,7 @@ func TestPlayerChoosesAMoveOnFullBoard(t *testing.T) {
 	player := NewPlayerStrategyFirstAvailableMove(1)
 
 	thisBoard := [BoardHeight][BoardWidth]int{
-		{1, 0, 1, 0, 1, 0, 1},
+		{0, 0, 1, 0, 1, 0, 1},
 		{1, 0, 1, 0, 1, 0, 1},
 		{0, 1, 0, 1, 0, 1, 0},
 		{0, 1, 0, 1, 0, 1, 0},
@@ -51,9 +53,48 @@ func TestPlayerChoosesAMoveOnFullBoard(t *testing.T) {
 	}
 	gameBoard := NewInProgressGameBoard(thisBoard)
 
-	chosenColumn := player.PlayerChoosesAMove(gameBoard)
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
 
 	if chosenColumn != StatusNoAvailableMove {
 		t.Errorf(`TestPlayerChoosesAMoveOnFullBoard expected NoAvailableMoveStatus but played in %v column`, chosenColumn)
 	}
 }
+
+func TestCenterFirstColumnOrderOnStandardBoard(t *testing.T) {
+	expected := []int{3, 2, 4, 1, 5, 0, 6}
+
+	columns := CenterFirstColumnOrder(BoardWidth)
+
+	if !reflect.DeepEqual(columns, expected) {
+		t.Errorf(`TestCenterFirstColumnOrderOnStandardBoard expected %v but got %v`, expected, columns)
+	}
+}
+
+func TestCenterFirstColumnOrderOnEvenWidth(t *testing.T) {
+	expected := []int{4, 3, 5, 2, 6, 1, 7, 0}
+
+	columns := CenterFirstColumnOrder(8)
+
+	if !reflect.DeepEqual(columns, expected) {
+		t.Errorf(`TestCenterFirstColumnOrderOnEvenWidth expected %v but got %v`, expected, columns)
+	}
+}
+
+func TestPlayerChoosesAMoveOnLargerBoard(t *testing.T) {
+	player := NewPlayerStrategyFirstAvailableMove(1)
+	gameBoard := NewGameBoardOfSize(9, 7, WinningLength)
+
+	chosenColumn := player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
+
+	if chosenColumn != 4 {
+		t.Errorf(`TestPlayerChoosesAMoveOnLargerBoard expected column 4 but played in %v column`, chosenColumn)
+	}
+}
+
+func TestGetRegisteredPlayerStrategyNamesIsSorted(t *testing.T) {
+	names := GetRegisteredPlayerStrategyNames()
+
+	if !slices.IsSorted(names) || !slices.Contains(names, "firstavailable") || !slices.Contains(names, "random") {
+		t.Errorf(`TestGetRegisteredPlayerStrategyNamesIsSorted expected sorted names including firstavailable and random but got %v`, names)
+	}
+}
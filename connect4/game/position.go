@@ -0,0 +1,84 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Pieces in the row notation read by NewGameBoardFromRows
+const (
+	RowNotationEmpty   = '.'
+	RowNotationPlayer1 = '1'
+	RowNotationPlayer2 = '2'
+)
+
+// NewGameBoardFromMoves plays the moves on a standard board
+// Moves are columns numbered from 1 at the left, as in a game record, and player 1 moves first.
+func NewGameBoardFromMoves(moves string) (*GameBoard, error) {
+	return NewGameBoardOfSizeFromMoves(moves, BoardWidth, BoardHeight, WinningLength)
+}
+
+func NewGameBoardOfSizeFromMoves(moves string, width int, height int, winningLength int) (*GameBoard, error) {
+	if width < 1 || height < 1 || winningLength < 1 {
+		return nil, fmt.Errorf("invalid board size %dx%d connect %d", width, height, winningLength)
+	}
+
+	columns, err := ParseMoves(moves, width)
+	if err != nil {
+		return nil, err
+	}
+
+	record := GameRecord{Width: width, Height: height, WinningLength: winningLength, Moves: columns}
+	return record.GameBoard()
+}
+
+// NewGameBoardFromRows builds a board from rows listed top to bottom, separated by a / or a new line
+//
+// Each row has one character per column, . for an empty space, 1 for player 1 and 2 for player 2,
+// for example "......./......./......./......./...2.../..112..".
+// The pieces must rest on the bottom row or on another piece, and player 1 moves first
+// so it has the same number of pieces as player 2 or one more.
+// The board has no turn history as the order of the moves is not known.
+func NewGameBoardFromRows(rows string, winningLength int) (*GameBoard, error) {
+	if winningLength < 1 {
+		return nil, fmt.Errorf("invalid winning length %d", winningLength)
+	}
+
+	matrix := [][]int{}
+	for _, line := range strings.FieldsFunc(rows, func(r rune) bool { return r == '/' || r == '\n' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		row := make([]int, 0, len(line))
+		for _, piece := range line {
+			switch piece {
+			case RowNotationEmpty:
+				row = append(row, NoPlayer)
+			case RowNotationPlayer1:
+				row = append(row, 1)
+			case RowNotationPlayer2:
+				row = append(row, 2)
+			default:
+				return nil, fmt.Errorf("row %d has the unknown piece %q, expected %q, %q or %q", len(matrix), piece, RowNotationEmpty, RowNotationPlayer1, RowNotationPlayer2)
+			}
+		}
+
+		if len(matrix) > 0 && len(row) != len(matrix[0]) {
+			return nil, fmt.Errorf("row %d has %d columns but row 0 has %d", len(matrix), len(row), len(matrix[0]))
+		}
+		matrix = append(matrix, row)
+	}
+
+	if len(matrix) == 0 {
+		return nil, fmt.Errorf("a board needs at least one row")
+	}
+
+	gameBoard := newUncheckedInProgressGameBoard(matrix, winningLength)
+	if err := validatePosition(gameBoard, 1); err != nil {
+		return nil, err
+	}
+
+	return gameBoard, nil
+}
@@ -0,0 +1,74 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewGameBoardFromMoves(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("4455433")
+	if err != nil {
+		t.Fatalf(`TestNewGameBoardFromMoves returned error %v`, err)
+	}
+
+	expected, err := NewGameBoardFromRows(`
+		.......
+		.......
+		.......
+		...1...
+		..122..
+		..211..
+	`, WinningLength)
+	if err != nil {
+		t.Fatalf(`TestNewGameBoardFromMoves could not build the expected board: %v`, err)
+	}
+	if !reflect.DeepEqual(gameBoard.board, expected.board) || len(gameBoard.GetTurnHistory()) != 7 {
+		t.Errorf(`TestNewGameBoardFromMoves expected %v but got %v`, expected.board, gameBoard.board)
+	}
+}
+
+func TestNewGameBoardFromMovesRejectsIllegalMoves(t *testing.T) {
+	if _, err := NewGameBoardFromMoves("1111111"); !errors.Is(err, ErrColumnFull) || !strings.Contains(err.Error(), "move 7") {
+		t.Errorf(`TestNewGameBoardFromMovesRejectsIllegalMoves expected the seventh piece in column 1 to be rejected but got %v`, err)
+	}
+	if _, err := NewGameBoardFromMoves("4408"); err == nil || !strings.Contains(err.Error(), "move 3") {
+		t.Errorf(`TestNewGameBoardFromMovesRejectsIllegalMoves expected column 0 to be rejected but got %v`, err)
+	}
+	if _, err := NewGameBoardFromMoves("12121214"); err == nil || !strings.Contains(err.Error(), "after the game was won") {
+		t.Errorf(`TestNewGameBoardFromMovesRejectsIllegalMoves expected a move after the win to be rejected but got %v`, err)
+	}
+}
+
+func TestNewGameBoardOfSizeFromMoves(t *testing.T) {
+	gameBoard, err := NewGameBoardOfSizeFromMoves("10 11 10", 11, 3, 3)
+
+	if err != nil || gameBoard.GetSpaceOwnership(9, 1) != 1 || gameBoard.GetSpaceOwnership(10, 2) != 2 {
+		t.Errorf(`TestNewGameBoardOfSizeFromMoves expected pieces in the last two columns but got %v: %v`, gameBoard, err)
+	}
+}
+
+func TestNewGameBoardFromRowsRejectsFloatingPieces(t *testing.T) {
+	_, err := NewGameBoardFromRows("...1/..../.2../.1..", 3)
+
+	if err == nil || !strings.Contains(err.Error(), "[3][0] is floating above the empty space [3][1]") {
+		t.Errorf(`TestNewGameBoardFromRowsRejectsFloatingPieces expected the piece at [3][0] to be floating but got %v`, err)
+	}
+}
+
+func TestNewGameBoardFromRowsRejectsPieceCounts(t *testing.T) {
+	_, err := NewGameBoardFromRows(".../2../22.", 3)
+
+	if err == nil || !strings.Contains(err.Error(), "player 1 has 0 pieces and player 2 has 3") {
+		t.Errorf(`TestNewGameBoardFromRowsRejectsPieceCounts expected the piece counts to be rejected but got %v`, err)
+	}
+}
+
+func TestNewGameBoardFromRowsRejectsMalformedRows(t *testing.T) {
+	for _, rows := range []string{"..../...", "..x.", ""} {
+		if _, err := NewGameBoardFromRows(rows, 3); err == nil {
+			t.Errorf(`TestNewGameBoardFromRowsRejectsMalformedRows expected %q to be rejected`, rows)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	RendererASCII    = "ascii"
+	RendererUnicode  = "unicode"
+	RendererCompact  = "compact"
+	RendererMarkdown = "markdown"
+)
+
+// RendererNames lists the option names NewRenderer accepts
+var RendererNames = []string{RendererASCII, RendererUnicode, RendererCompact, RendererMarkdown}
+
+// Renderer draws a board to w
+//
+// Each renderer labels the board with the turn of the last piece played, counting from 0 like GameResult.Turn.
+type Renderer interface {
+	Render(w io.Writer, gameBoard BoardSnapshot) error
+}
+
+// NewRenderer returns the renderer with the option name, one of RendererNames
+func NewRenderer(name string) (Renderer, error) {
+	switch name {
+	case RendererASCII, "":
+		return ASCIIRenderer{}, nil
+	case RendererUnicode:
+		return UnicodeRenderer{}, nil
+	case RendererCompact:
+		return CompactRenderer{}, nil
+	case RendererMarkdown:
+		return MarkdownRenderer{}, nil
+	default:
+		return nil, fmt.Errorf("unknown renderer %q, expected one of %s", name, strings.Join(RendererNames, ", "))
+	}
+}
+
+// ASCIIRenderer draws the board with the players' numbers between bars, marking the winning pieces with a *
+//
+//	|  _  _  _  _  _  _  _  |
+//	|  _  _  _  1  2  _  _  |
+//	|-----------------------|
+//	|        Turn   1       |
+type ASCIIRenderer struct{}
+
+func (renderer ASCIIRenderer) Render(w io.Writer, gameBoard BoardSnapshot) error {
+	width := gameBoard.GetWidth()
+	winningCells := winningCellSet(gameBoard.WinningLines())
+
+	var drawn strings.Builder
+	for y := range gameBoard.GetHeight() {
+		drawn.WriteString("|  ")
+		for x := range width {
+			owner := gameBoard.GetSpaceOwnership(x, y)
+
+			switch {
+			case owner == NoPlayer:
+				drawn.WriteString(`_  `)
+			case winningCells[Cell{Column: x, Row: y}]:
+				fmt.Fprintf(&drawn, `%d* `, owner)
+			default:
+				fmt.Fprintf(&drawn, `%d  `, owner)
+			}
+		}
+		drawn.WriteString("|\n")
+	}
+
+	// the footer spans the same width as the rows above it
+	innerWidth := 3*width + 2
+	drawn.WriteString("|" + strings.Repeat("-", innerWidth) + "|\n")
+
+	label := fmt.Sprintf("Turn  %2d", lastTurnNumber(gameBoard))
+	leftPadding := max(0, (innerWidth-len(label)+1)/2)
+	rightPadding := max(0, innerWidth-len(label)-leftPadding)
+	fmt.Fprintf(&drawn, "|%s%s%s|\n\n", strings.Repeat(" ", leftPadding), label, strings.Repeat(" ", rightPadding))
+
+	_, err := io.WriteString(w, drawn.String())
+	return err
+}
+
+// lastTurnNumber is the turn the last piece was played on, 0 before the first piece
+func lastTurnNumber(gameBoard BoardSnapshot) int {
+	return max(0, len(gameBoard.GetTurnHistory())-1)
+}
+
+// UnicodeRenderer draws the board in a box-drawing grid with ● for player 1 and ○ for player 2,
+// the winning pieces are ◉ and ◎
+type UnicodeRenderer struct{}
+
+func (renderer UnicodeRenderer) Render(w io.Writer, gameBoard BoardSnapshot) error {
+	width := gameBoard.GetWidth()
+	winningCells := winningCellSet(gameBoard.WinningLines())
+	border := func(left string, middle string, right string) string {
+		return left + strings.Repeat("───"+middle, width-1) + "───" + right + "\n"
+	}
+
+	var drawn strings.Builder
+	drawn.WriteString(border("┌", "┬", "┐"))
+	for y := range gameBoard.GetHeight() {
+		if y > 0 {
+			drawn.WriteString(border("├", "┼", "┤"))
+		}
+		for x := range width {
+			fmt.Fprintf(&drawn, "│ %s ", unicodeDisc(gameBoard.GetSpaceOwnership(x, y), winningCells[Cell{Column: x, Row: y}]))
+		}
+		drawn.WriteString("│\n")
+	}
+	drawn.WriteString(border("└", "┴", "┘"))
+
+	for x := range width {
+		fmt.Fprintf(&drawn, "%3d ", x+1)
+	}
+	fmt.Fprintf(&drawn, "\nTurn %d\n\n", lastTurnNumber(gameBoard))
+
+	_, err := io.WriteString(w, drawn.String())
+	return err
+}
+
+func unicodeDisc(owner int, winning bool) string {
+	switch {
+	case owner == NoPlayer:
+		return " "
+	case owner == 1 && winning:
+		return "◉"
+	case owner == 1:
+		return "●"
+	case owner == 2 && winning:
+		return "◎"
+	case owner == 2:
+		return "○"
+	default:
+		return fmt.Sprint(owner % 10)
+	}
+}
+
+// CompactRenderer writes the board on one line in the row notation NewGameBoardFromRows reads,
+// followed by the turn
+//
+//	......./......./......./......./......./...12.. 1
+type CompactRenderer struct{}
+
+func (renderer CompactRenderer) Render(w io.Writer, gameBoard BoardSnapshot) error {
+	_, err := fmt.Fprintf(w, "%s %d\n", rowNotation(gameBoard), lastTurnNumber(gameBoard))
+	return err
+}
+
+// rowNotation is the board's rows from the top, separated by /, see NewGameBoardFromRows
+func rowNotation(gameBoard boardReader) string {
+	rows := make([]string, gameBoard.GetHeight())
+	for y := range rows {
+		var row strings.Builder
+		for x := range gameBoard.GetWidth() {
+			switch owner := gameBoard.GetSpaceOwnership(x, y); owner {
+			case NoPlayer:
+				row.WriteRune(RowNotationEmpty)
+			case 1:
+				row.WriteRune(RowNotationPlayer1)
+			case 2:
+				row.WriteRune(RowNotationPlayer2)
+			default:
+				fmt.Fprint(&row, owner%10)
+			}
+		}
+		rows[y] = row.String()
+	}
+
+	return strings.Join(rows, "/")
+}
+
+// MarkdownRenderer writes the board as a Markdown table with a column for each board column,
+// the winning pieces are in bold
+type MarkdownRenderer struct{}
+
+func (renderer MarkdownRenderer) Render(w io.Writer, gameBoard BoardSnapshot) error {
+	width := gameBoard.GetWidth()
+	winningCells := winningCellSet(gameBoard.WinningLines())
+	tableRow := func(cells []string) string {
+		return "| " + strings.Join(cells, " | ") + " |\n"
+	}
+
+	var drawn strings.Builder
+	header := make([]string, width)
+	for x := range header {
+		header[x] = fmt.Sprint(x + 1)
+	}
+	drawn.WriteString(tableRow(header))
+	drawn.WriteString(tableRow(slices.Repeat([]string{":-:"}, width)))
+
+	for y := range gameBoard.GetHeight() {
+		cells := make([]string, width)
+		for x := range cells {
+			owner := gameBoard.GetSpaceOwnership(x, y)
+			switch {
+			case owner == NoPlayer:
+				cells[x] = " "
+			case winningCells[Cell{Column: x, Row: y}]:
+				cells[x] = fmt.Sprintf("**%d**", owner)
+			default:
+				cells[x] = fmt.Sprint(owner)
+			}
+		}
+		drawn.WriteString(tableRow(cells))
+	}
+	fmt.Fprintf(&drawn, "\nTurn %d\n\n", lastTurnNumber(gameBoard))
+
+	_, err := io.WriteString(w, drawn.String())
+	return err
+}
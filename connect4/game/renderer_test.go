@@ -0,0 +1,111 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func renderMoves(t *testing.T, renderer Renderer, width int, height int, winningLength int, moves string) string {
+	gameBoard := NewGameBoardOfSize(width, height, winningLength)
+	for ndx, move := range moves {
+		if err := gameBoard.PlayPiece(ndx%NumPlayers+1, int(move-'1')); err != nil {
+			t.Fatalf(`renderMoves could not play %s: %v`, moves, err)
+		}
+	}
+
+	var rendered bytes.Buffer
+	if err := renderer.Render(&rendered, gameBoard.Snapshot()); err != nil {
+		t.Fatalf(`renderMoves returned error %v`, err)
+	}
+
+	return rendered.String()
+}
+
+func TestASCIIRenderer(t *testing.T) {
+	expected := "" +
+		"|  _  _  _  _  |\n" +
+		"|  _  1  _  _  |\n" +
+		"|  _  1  2  _  |\n" +
+		"|--------------|\n" +
+		"|   Turn   2   |\n\n"
+
+	if rendered := renderMoves(t, ASCIIRenderer{}, 4, 3, 3, "232"); rendered != expected {
+		t.Errorf(`TestASCIIRenderer expected %q but got %q`, expected, rendered)
+	}
+}
+
+func TestUnicodeRenderer(t *testing.T) {
+	expected := "" +
+		"┌───┬───┬───┐\n" +
+		"│ ◎ │   │   │\n" +
+		"├───┼───┼───┤\n" +
+		"│ ◎ │ ◉ │ ◉ │\n" +
+		"└───┴───┴───┘\n" +
+		"  1   2   3 \n" +
+		"Turn 3\n\n"
+
+	if rendered := renderMoves(t, UnicodeRenderer{}, 3, 2, 2, "2131"); rendered != expected {
+		t.Errorf(`TestUnicodeRenderer expected %q but got %q`, expected, rendered)
+	}
+}
+
+func TestCompactRendererRoundTrips(t *testing.T) {
+	rendered := renderMoves(t, CompactRenderer{}, BoardWidth, BoardHeight, WinningLength, "4453")
+
+	if rendered != "......./......./......./......./...2.../..211.. 3\n" {
+		t.Errorf(`TestCompactRendererRoundTrips got %q`, rendered)
+	}
+
+	rows, _, _ := strings.Cut(rendered, " ")
+	gameBoard, err := NewGameBoardFromRows(rows, WinningLength)
+	if err != nil {
+		t.Fatalf(`TestCompactRendererRoundTrips could not read back %q: %v`, rows, err)
+	}
+	if rowNotation(gameBoard) != rows {
+		t.Errorf(`TestCompactRendererRoundTrips expected %q but read back %q`, rows, rowNotation(gameBoard))
+	}
+}
+
+func TestMarkdownRenderer(t *testing.T) {
+	expected := "" +
+		"| 1 | 2 | 3 |\n" +
+		"| :-: | :-: | :-: |\n" +
+		"|   |   |   |\n" +
+		"| **1** | **1** | 2 |\n" +
+		"\nTurn 2\n\n"
+
+	if rendered := renderMoves(t, MarkdownRenderer{}, 3, 2, 2, "132"); rendered != expected {
+		t.Errorf(`TestMarkdownRenderer expected %q but got %q`, expected, rendered)
+	}
+}
+
+func TestNewRenderer(t *testing.T) {
+	for _, name := range RendererNames {
+		if _, err := NewRenderer(name); err != nil {
+			t.Errorf(`TestNewRenderer returned error %v for %s`, err, name)
+		}
+	}
+
+	if _, err := NewRenderer("braille"); err == nil {
+		t.Errorf(`TestNewRenderer expected an error for an unknown renderer`)
+	}
+}
+
+func TestGamePrintsWithTheConfiguredRenderer(t *testing.T) {
+	var printed bytes.Buffer
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "firstavailable"
+	config.ModuloToPrintGameBoard = 0
+	config.Output = &printed
+	config.Renderer = CompactRenderer{}
+
+	result := PlayConnect4(config)
+
+	lines := strings.Split(strings.TrimSpace(printed.String()), "\n")
+	if last := lines[len(lines)-1]; !strings.HasSuffix(last, " "+fmt.Sprint(result.Turn)) || strings.Count(last, "/") != BoardHeight-1 {
+		t.Errorf(`TestGamePrintsWithTheConfiguredRenderer expected the final board in row notation but got %q`, last)
+	}
+}
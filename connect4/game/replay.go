@@ -0,0 +1,89 @@
+package game
+
+import (
+	"fmt"
+)
+
+// Replay steps forwards and backwards through the moves of a game record
+type Replay struct {
+	record    GameRecord
+	ply       int
+	gameBoard *GameBoard
+}
+
+// NewReplay checks every move of the record can be played and starts the replay before the first move
+func NewReplay(record GameRecord) (*Replay, error) {
+	if _, err := record.GameBoard(); err != nil {
+		return nil, err
+	}
+
+	return &Replay{record: record, gameBoard: NewGameBoardOfSize(record.Width, record.Height, record.WinningLength)}, nil
+}
+
+// Ply is the number of moves played so far
+func (replay *Replay) Ply() int {
+	return replay.ply
+}
+
+// Plies is the number of moves in the game
+func (replay *Replay) Plies() int {
+	return len(replay.record.Moves)
+}
+
+func (replay *Replay) Record() GameRecord {
+	return replay.record
+}
+
+// GameBoard is the board after Ply moves
+func (replay *Replay) GameBoard() GameBoardActions {
+	return replay.gameBoard
+}
+
+// LastTurn is the move that reached the current board, false before the first move
+func (replay *Replay) LastTurn() (RecordedTurn, bool) {
+	turnHistory := replay.gameBoard.GetTurnHistory()
+	if len(turnHistory) == 0 {
+		return RecordedTurn{}, false
+	}
+
+	return turnHistory[len(turnHistory)-1], true
+}
+
+// Forward plays the next move and reports whether there was one
+// The moves are played with PlayHypothetical so that Back can Undo them.
+func (replay *Replay) Forward() bool {
+	if replay.ply >= replay.Plies() {
+		return false
+	}
+
+	replay.gameBoard.PlayHypothetical(replay.ply%NumPlayers+1, replay.record.Moves[replay.ply])
+	replay.ply++
+	return true
+}
+
+// Back takes back the last move and reports whether there was one
+func (replay *Replay) Back() bool {
+	if replay.ply == 0 {
+		return false
+	}
+
+	replay.gameBoard.Undo()
+	replay.ply--
+	return true
+}
+
+// JumpTo shows the board after ply moves, stepping Forward or Back from the current ply
+func (replay *Replay) JumpTo(ply int) error {
+	if ply < 0 || ply > replay.Plies() {
+		return fmt.Errorf("ply %d is not between 0 and %d", ply, replay.Plies())
+	}
+
+	for replay.ply > ply {
+		replay.Back()
+	}
+	for replay.ply < ply {
+		replay.Forward()
+	}
+
+	return nil
+}
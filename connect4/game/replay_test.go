@@ -0,0 +1,57 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReplayStepsForwardAndBack(t *testing.T) {
+	record, _ := ParseGameRecord(testGameRecord)
+	replay, err := NewReplay(record)
+	if err != nil {
+		t.Fatalf(`TestReplayStepsForwardAndBack returned error %v`, err)
+	}
+
+	if replay.Back() || replay.Ply() != 0 {
+		t.Errorf(`TestReplayStepsForwardAndBack expected no move to take back at the start`)
+	}
+
+	for replay.Forward() {
+	}
+	if replay.Ply() != replay.Plies() || replay.GameBoard().IsVictory() != 1 {
+		t.Errorf(`TestReplayStepsForwardAndBack expected player 1 to win after %d moves but got ply %d`, replay.Plies(), replay.Ply())
+	}
+
+	replay.Back()
+	lastTurn, _ := replay.LastTurn()
+	expected := RecordedTurn{PlayerValue: 2, Column: 5, Row: BoardHeight - 2}
+	if replay.Ply() != 6 || replay.GameBoard().IsVictory() != NoPlayer || !reflect.DeepEqual(lastTurn, expected) {
+		t.Errorf(`TestReplayStepsForwardAndBack expected %+v at ply 6 but got %+v at ply %d`, expected, lastTurn, replay.Ply())
+	}
+}
+
+func TestReplayJumpTo(t *testing.T) {
+	record, _ := ParseGameRecord(testGameRecord)
+	replay, _ := NewReplay(record)
+
+	if err := replay.JumpTo(3); err != nil || len(replay.GameBoard().GetTurnHistory()) != 3 {
+		t.Errorf(`TestReplayJumpTo expected 3 moves on the board but got %v: %v`, replay.GameBoard().GetTurnHistory(), err)
+	}
+	if err := replay.JumpTo(8); err == nil || replay.Ply() != 3 {
+		t.Errorf(`TestReplayJumpTo expected ply 8 to be rejected and to stay at ply 3 but is at %d`, replay.Ply())
+	}
+
+	replay.JumpTo(replay.Plies())
+	replay.JumpTo(1)
+	if turnHistory := replay.GameBoard().GetTurnHistory(); len(turnHistory) != 1 || turnHistory[0].Column != record.Moves[0] {
+		t.Errorf(`TestReplayJumpTo expected only the first move after jumping back to ply 1 but got %v`, turnHistory)
+	}
+}
+
+func TestNewReplayRejectsIllegalRecord(t *testing.T) {
+	record := GameRecord{Width: 7, Height: 2, WinningLength: 4, Moves: []int{0, 0, 0}}
+
+	if _, err := NewReplay(record); err == nil {
+		t.Errorf(`TestNewReplayRejectsIllegalRecord expected the third move in a full column to be rejected`)
+	}
+}
@@ -0,0 +1,115 @@
+package game
+
+// BoardSnapshot is a read only copy of a board, taken with Snapshot
+//
+// Strategies are given a snapshot of the game board so that nothing they do can change the real game.
+// A snapshot never changes, to try moves on it take a Clone.
+// Taking a snapshot copies nothing, the board copies what the snapshot shares when it next changes it.
+type BoardSnapshot struct {
+	board PlayableGameBoard
+}
+
+func (snapshot BoardSnapshot) AvailableRow(column int) int {
+	return snapshot.board.AvailableRow(column)
+}
+
+func (snapshot BoardSnapshot) GetHeight() int {
+	return snapshot.board.GetHeight()
+}
+
+func (snapshot BoardSnapshot) GetSpaceOwnership(column int, row int) int {
+	return snapshot.board.GetSpaceOwnership(column, row)
+}
+
+func (snapshot BoardSnapshot) GetTurnHistory() []RecordedTurn {
+	return snapshot.board.GetTurnHistory()
+}
+
+func (snapshot BoardSnapshot) GetWidth() int {
+	return snapshot.board.GetWidth()
+}
+
+func (snapshot BoardSnapshot) GetWinningLength() int {
+	return snapshot.board.GetWinningLength()
+}
+
+func (snapshot BoardSnapshot) IsPlayersSpace(player PlayerStrategy, column int, row int) bool {
+	return snapshot.board.IsPlayersSpace(player, column, row)
+}
+
+func (snapshot BoardSnapshot) IsVictory() int {
+	return snapshot.board.IsVictory()
+}
+
+func (snapshot BoardSnapshot) WinningLines() []WinningLine {
+	return snapshot.board.WinningLines()
+}
+
+// Clone returns a copy of the snapshot that can be played on
+func (snapshot BoardSnapshot) Clone() PlayableGameBoard {
+	return snapshot.board.Clone()
+}
+
+// Snapshot returns the snapshot itself, it can be shared because it never changes
+func (snapshot BoardSnapshot) Snapshot() BoardSnapshot {
+	return snapshot
+}
+
+// hypotheticalMoves tracks the moves PlayHypothetical played that Undo can take back, and the moves Redo can play again
+type hypotheticalMoves struct {
+	count       int
+	undoneTurns []RecordedTurn
+}
+
+func (moves *hypotheticalMoves) played() {
+	moves.count++
+	moves.undoneTurns = nil
+}
+
+// commit makes the moves played so far permanent, after PlayPiece
+func (moves *hypotheticalMoves) commit() {
+	moves.count = 0
+	moves.undoneTurns = nil
+}
+
+func (moves hypotheticalMoves) canUndo() bool {
+	return moves.count > 0
+}
+
+func (moves *hypotheticalMoves) undone(turn RecordedTurn) {
+	moves.count--
+	moves.undoneTurns = append(moves.undoneTurns, turn)
+}
+
+func (moves *hypotheticalMoves) redo(gameBoard PlayableGameBoard) error {
+	if len(moves.undoneTurns) == 0 {
+		return ErrNothingToRedo
+	}
+
+	turn := moves.undoneTurns[len(moves.undoneTurns)-1]
+	remaining := moves.undoneTurns[:len(moves.undoneTurns)-1]
+
+	if err := gameBoard.PlayHypothetical(turn.PlayerValue, turn.Column); err != nil {
+		return err
+	}
+
+	// playing a move forgets the undone moves, but redoing one keeps the rest
+	moves.undoneTurns = remaining
+
+	return nil
+}
+
+func (moves hypotheticalMoves) copy() hypotheticalMoves {
+	return hypotheticalMoves{count: moves.count, undoneTurns: append([]RecordedTurn(nil), moves.undoneTurns...)}
+}
+
+// copyTurnHistory copies the turns, and the illegal attempts of each turn, so that the copy shares nothing
+func copyTurnHistory(turnHistory []RecordedTurn) []RecordedTurn {
+	copied := make([]RecordedTurn, len(turnHistory))
+	for ndx, turn := range turnHistory {
+		copied[ndx] = turn
+		copied[ndx].IllegalAttempts = append([]int(nil), turn.IllegalAttempts...)
+	}
+
+	return copied
+}
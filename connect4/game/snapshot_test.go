@@ -0,0 +1,220 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func init() {
+	Register("testmeddler", NewPlayerStrategyMeddler)
+}
+
+// PlayerStrategyMeddler tries to change the board it is given before choosing the first available column
+type PlayerStrategyMeddler struct {
+	PlayerStrategyFirstAvailableMove
+}
+
+func NewPlayerStrategyMeddler(playerValue int) PlayerStrategy {
+	return &PlayerStrategyMeddler{PlayerStrategyFirstAvailableMove{name: "Meddling Strategy", playerValue: playerValue}}
+}
+
+func (p PlayerStrategyMeddler) PlayerChoosesAMove(gameBoard BoardView) int {
+	if turnHistory := gameBoard.GetTurnHistory(); len(turnHistory) > 0 {
+		turnHistory[0].Column = 99
+	}
+
+	clone := gameBoard.Clone()
+	clone.PlayHypothetical(p.playerValue, 1)
+	clone.PlayPiece(p.playerValue, 2)
+
+	return p.PlayerStrategyFirstAvailableMove.PlayerChoosesAMove(gameBoard)
+}
+
+func newTestBoards(t *testing.T) map[string]PlayableGameBoard {
+	return map[string]PlayableGameBoard{
+		BoardImplementationArray:    NewGameBoard(),
+		BoardImplementationBitBoard: newStandardBitBoard(t),
+	}
+}
+
+func boardCells(gameBoard GameBoardActions) [][]int {
+	cells := make([][]int, gameBoard.GetWidth())
+	for x := range cells {
+		cells[x] = make([]int, gameBoard.GetHeight())
+		for y := range cells[x] {
+			cells[x][y] = gameBoard.GetSpaceOwnership(x, y)
+		}
+	}
+
+	return cells
+}
+
+func TestPlayHypotheticalAndUndo(t *testing.T) {
+	for name, gameBoard := range newTestBoards(t) {
+		gameBoard.PlayPiece(1, 3)
+		before := boardCells(gameBoard)
+
+		if err := gameBoard.PlayHypothetical(2, 3); err != nil {
+			t.Fatalf(`TestPlayHypotheticalAndUndo %s returned error %v`, name, err)
+		}
+		gameBoard.PlayHypothetical(1, 4)
+
+		if gameBoard.GetSpaceOwnership(4, BoardHeight-1) != 1 {
+			t.Errorf(`TestPlayHypotheticalAndUndo %s expected the hypothetical piece to be played`, name)
+		}
+
+		for range 2 {
+			if err := gameBoard.Undo(); err != nil {
+				t.Fatalf(`TestPlayHypotheticalAndUndo %s returned error %v`, name, err)
+			}
+		}
+
+		if !reflect.DeepEqual(boardCells(gameBoard), before) {
+			t.Errorf(`TestPlayHypotheticalAndUndo %s expected Undo to restore the board`, name)
+		}
+		if len(gameBoard.GetTurnHistory()) != 1 {
+			t.Errorf(`TestPlayHypotheticalAndUndo %s expected Undo to restore the history but got %v`, name, gameBoard.GetTurnHistory())
+		}
+		if err := gameBoard.Undo(); !errors.Is(err, ErrNothingToUndo) {
+			t.Errorf(`TestPlayHypotheticalAndUndo %s expected PlayPiece to be permanent but Undo returned %v`, name, err)
+		}
+	}
+}
+
+func TestRedo(t *testing.T) {
+	for name, gameBoard := range newTestBoards(t) {
+		gameBoard.PlayHypothetical(1, 3)
+		gameBoard.PlayHypothetical(2, 4)
+		gameBoard.Undo()
+		gameBoard.Undo()
+
+		if err := gameBoard.Redo(); err != nil {
+			t.Fatalf(`TestRedo %s returned error %v`, name, err)
+		}
+		if err := gameBoard.Redo(); err != nil {
+			t.Fatalf(`TestRedo %s returned error %v`, name, err)
+		}
+
+		expected := []RecordedTurn{{PlayerValue: 1, Column: 3, Row: BoardHeight - 1}, {PlayerValue: 2, Column: 4, Row: BoardHeight - 1}}
+		if !reflect.DeepEqual(gameBoard.GetTurnHistory(), expected) {
+			t.Errorf(`TestRedo %s expected %v but got %v`, name, expected, gameBoard.GetTurnHistory())
+		}
+		if err := gameBoard.Redo(); !errors.Is(err, ErrNothingToRedo) {
+			t.Errorf(`TestRedo %s expected ErrNothingToRedo but got %v`, name, err)
+		}
+
+		gameBoard.Undo()
+		gameBoard.PlayHypothetical(2, 5)
+		if err := gameBoard.Redo(); !errors.Is(err, ErrNothingToRedo) {
+			t.Errorf(`TestRedo %s expected a new move to forget the undone moves but got %v`, name, err)
+		}
+	}
+}
+
+func TestCloneAndSnapshotDoNotShareState(t *testing.T) {
+	for name, gameBoard := range newTestBoards(t) {
+		gameBoard.PlayPiece(1, 3)
+		before := boardCells(gameBoard)
+
+		snapshot := gameBoard.Snapshot()
+		clone := snapshot.Clone()
+		clone.PlayPiece(2, 3)
+		clone.PlayHypothetical(1, 3)
+		snapshot.GetTurnHistory()[0].Column = 99
+
+		gameBoard.PlayPiece(2, 0)
+
+		if !reflect.DeepEqual(boardCells(snapshot), before) {
+			t.Errorf(`TestCloneAndSnapshotDoNotShareState %s expected the snapshot to be unchanged`, name)
+		}
+		if snapshot.GetTurnHistory()[0].Column != 3 || len(snapshot.GetTurnHistory()) != 1 {
+			t.Errorf(`TestCloneAndSnapshotDoNotShareState %s expected the snapshot history to be unchanged but got %v`, name, snapshot.GetTurnHistory())
+		}
+		if gameBoard.GetSpaceOwnership(3, BoardHeight-2) != NoPlayer {
+			t.Errorf(`TestCloneAndSnapshotDoNotShareState %s expected the board to be unchanged by its clone`, name)
+		}
+	}
+}
+
+func TestSnapshotIsUnchangedByTakeBacksAndIllegalAttempts(t *testing.T) {
+	for name, playableBoard := range newTestBoards(t) {
+		gameBoard := playableBoard.(engineBoard)
+		for ndx, column := range []int{3, 3, 2, 4} {
+			gameBoard.PlayPiece(ndx%NumPlayers+1, column)
+		}
+		before := boardCells(gameBoard)
+		beforeHistory := gameBoard.GetTurnHistory()
+
+		snapshot := gameBoard.Snapshot()
+		gameBoard.takeBack(2)
+		gameBoard.PlayPiece(1, 0)
+		gameBoard.recordIllegalAttempts([]int{9})
+		gameBoard.PlayPiece(2, 6)
+
+		if !reflect.DeepEqual(boardCells(snapshot), before) || !reflect.DeepEqual(snapshot.GetTurnHistory(), beforeHistory) {
+			t.Errorf(`TestSnapshotIsUnchangedByTakeBacksAndIllegalAttempts %s expected the snapshot to keep %v but got %v`, name, beforeHistory, snapshot.GetTurnHistory())
+		}
+		if history := gameBoard.GetTurnHistory(); len(history) != 4 || history[2].Column != 0 || !reflect.DeepEqual(history[2].IllegalAttempts, []int{9}) {
+			t.Errorf(`TestSnapshotIsUnchangedByTakeBacksAndIllegalAttempts %s expected the board to be changed but got %v`, name, history)
+		}
+	}
+}
+
+func TestSnapshotCopiesNothing(t *testing.T) {
+	for name, gameBoard := range newTestBoards(t) {
+		for ndx := range BoardWidth * 4 {
+			gameBoard.PlayPiece(ndx%NumPlayers+1, ndx%BoardWidth)
+		}
+
+		// one allocation for the snapshot itself, however many turns were played
+		if allocs := testing.AllocsPerRun(10, func() { gameBoard.Snapshot() }); allocs > 1 {
+			t.Errorf(`TestSnapshotCopiesNothing %s expected one allocation but got %v`, name, allocs)
+		}
+	}
+}
+
+func TestRegisteredStrategiesDoNotChangeTheBoard(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("4455433")
+	if err != nil {
+		t.Fatalf(`TestRegisteredStrategiesDoNotChangeTheBoard returned error %v`, err)
+	}
+	before := boardCells(gameBoard)
+	turnHistory := gameBoard.GetTurnHistory()
+
+	for _, name := range GetRegisteredAutomatedPlayerStrategyNames() {
+		if strings.HasPrefix(name, "test") {
+			continue
+		}
+
+		player := GetRegisteredPlayerStrategy(name, 2)
+		player.PlayerChoosesAMove(newTestBoardView(gameBoard, player.GetPlayerValue()))
+
+		if !reflect.DeepEqual(boardCells(gameBoard), before) || !reflect.DeepEqual(gameBoard.GetTurnHistory(), turnHistory) {
+			t.Errorf(`TestRegisteredStrategiesDoNotChangeTheBoard %s changed the board it was given`, name)
+		}
+	}
+}
+
+func TestStrategiesCannotChangeTheGameBoard(t *testing.T) {
+	for _, implementation := range []string{BoardImplementationArray, BoardImplementationBitBoard} {
+		config := NewDefaultGameConfig()
+		config.Player1 = "testmeddler"
+		config.Player2 = "firstavailable"
+		config.BoardImplementation = implementation
+		config.Output = nil
+		config.Debug = true
+
+		result := PlayConnect4(config)
+		if result.Err != nil || result.EndReason == GameEndInvalidPosition {
+			t.Fatalf(`TestStrategiesCannotChangeTheGameBoard %s expected the game to finish normally but got %v`, implementation, result.Message())
+		}
+
+		config.Player1 = "firstavailable"
+		expected := PlayConnect4(config)
+		if !reflect.DeepEqual(result.Turns, expected.Turns) {
+			t.Errorf(`TestStrategiesCannotChangeTheGameBoard %s expected the moves %v but got %v`, implementation, expected.Turns, result.Turns)
+		}
+	}
+}
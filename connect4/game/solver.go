@@ -0,0 +1,488 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// The solver only knows the standard board, its bit layout is the same as BitBoard's
+const solverWidth int = BoardWidth
+const solverHeight int = BoardHeight
+const solverSpaces int = solverWidth * solverHeight
+const solverMinScore int = -solverSpaces/2 + 3
+const solverMaxScore int = (solverSpaces+1)/2 - 3
+
+// solverTableSize is a prime so that keys spread over the whole table
+const solverTableSize int = 8388593
+
+type SolverOutcome string
+
+const (
+	SolverWin  SolverOutcome = "win"
+	SolverLoss SolverOutcome = "loss"
+	SolverDraw SolverOutcome = "draw"
+)
+
+// SolvedPosition is the game theoretic value of a position for the player to move
+type SolvedPosition struct {
+	// Score is positive when the player to move wins and negative when they lose
+	// The sooner the win the larger the score, a win with your last piece scores 1
+	Score int
+
+	Outcome SolverOutcome
+
+	// MovesToEnd counts the plies left in the game when both players play perfectly
+	MovesToEnd int
+}
+
+// ColumnScore is the value of dropping a piece in Column for the player making that move
+type ColumnScore struct {
+	Column   int
+	Playable bool
+	Result   SolvedPosition
+}
+
+type Solver struct {
+	keys   []uint64
+	values []int8
+	Nodes  uint64 // the number of positions searched, useful when comparing move ordering
+}
+
+func NewSolver() *Solver {
+	return &Solver{
+		keys:   make([]uint64, solverTableSize),
+		values: make([]int8, solverTableSize),
+	}
+}
+
+// solverPosition stores the pieces of the player to move and a mask of every piece on the board
+type solverPosition struct {
+	current uint64
+	mask    uint64
+	moves   int
+}
+
+func solverBottomMask(column int) uint64 {
+	return uint64(1) << (column * (solverHeight + 1))
+}
+
+func solverTopMask(column int) uint64 {
+	return uint64(1) << (solverHeight - 1 + column*(solverHeight+1))
+}
+
+func solverColumnMask(column int) uint64 {
+	return ((uint64(1) << solverHeight) - 1) << (column * (solverHeight + 1))
+}
+
+var solverBottomRow, solverBoardMask = func() (uint64, uint64) {
+	bottom := uint64(0)
+	for column := range solverWidth {
+		bottom |= solverBottomMask(column)
+	}
+
+	return bottom, bottom * ((uint64(1) << solverHeight) - 1)
+}()
+
+func (position solverPosition) canPlay(column int) bool {
+	return position.mask&solverTopMask(column) == 0
+}
+
+func (position *solverPosition) play(move uint64) {
+	position.current ^= position.mask
+	position.mask |= move
+	position.moves++
+}
+
+func (position *solverPosition) playColumn(column int) {
+	position.play((position.mask + solverBottomMask(column)) & solverColumnMask(column))
+}
+
+func (position solverPosition) key() uint64 {
+	return position.current + position.mask
+}
+
+func (position solverPosition) possible() uint64 {
+	return (position.mask + solverBottomRow) & solverBoardMask
+}
+
+func (position solverPosition) winningSpaces() uint64 {
+	return solverWinningSpaces(position.current, position.mask)
+}
+
+func (position solverPosition) opponentWinningSpaces() uint64 {
+	return solverWinningSpaces(position.current^position.mask, position.mask)
+}
+
+func (position solverPosition) canWinNext() bool {
+	return position.winningSpaces()&position.possible() != 0
+}
+
+func (position solverPosition) isWinningColumn(column int) bool {
+	return position.winningSpaces()&position.possible()&solverColumnMask(column) != 0
+}
+
+// possibleNonLosingMoves leaves out moves that let the opponent win straight away
+// and returns zero when the opponent has more than one way to win next turn
+func (position solverPosition) possibleNonLosingMoves() uint64 {
+	possible := position.possible()
+	opponentWins := position.opponentWinningSpaces()
+	forcedMoves := possible & opponentWins
+
+	if forcedMoves != 0 {
+		if forcedMoves&(forcedMoves-1) != 0 {
+			return 0
+		}
+		possible = forcedMoves
+	}
+
+	return possible &^ (opponentWins >> 1)
+}
+
+// moveScore counts the winning spaces a move would create, better moves are searched first
+func (position solverPosition) moveScore(move uint64) int {
+	return bits.OnesCount64(solverWinningSpaces(position.current|move, position.mask))
+}
+
+// solverWinningSpaces marks every empty space that would complete four in a row for pieces
+func solverWinningSpaces(pieces uint64, mask uint64) uint64 {
+	// vertical
+	spaces := (pieces << 1) & (pieces << 2) & (pieces << 3)
+
+	// horizontal and both diagonals
+	for _, shift := range [3]int{solverHeight + 1, solverHeight, solverHeight + 2} {
+		pair := (pieces << shift) & (pieces << (2 * shift))
+		spaces |= pair & (pieces << (3 * shift))
+		spaces |= pair & (pieces >> shift)
+
+		pair = (pieces >> shift) & (pieces >> (2 * shift))
+		spaces |= pair & (pieces << shift)
+		spaces |= pair & (pieces >> (3 * shift))
+	}
+
+	return spaces & (solverBoardMask ^ mask)
+}
+
+func newSolverPositionFromMoves(moves string) (solverPosition, error) {
+	position := solverPosition{}
+
+	for ndx, character := range moves {
+		column := int(character - '1')
+		if column < 0 || column >= solverWidth {
+			return position, fmt.Errorf("move %d %q is not a column between 1 and %d", ndx+1, character, solverWidth)
+		}
+		if !position.canPlay(column) {
+			return position, fmt.Errorf("move %d plays in column %d which is already full", ndx+1, column+1)
+		}
+		if position.isWinningColumn(column) {
+			return position, fmt.Errorf("move %d in column %d ends the game", ndx+1, column+1)
+		}
+
+		position.playColumn(column)
+	}
+
+	return position, nil
+}
+
+// newSolverPositionFromActions reads a standard board where player 1 moved first and player 2 second
+func newSolverPositionFromActions(gba GameBoardActions) (solverPosition, error) {
+	position := solverPosition{}
+
+	if gba.GetWidth() != solverWidth || gba.GetHeight() != solverHeight || gba.GetWinningLength() != WinningLength {
+		return position, fmt.Errorf("the solver only plays %dx%d connect %d", solverWidth, solverHeight, WinningLength)
+	}
+
+	playerPieces := [NumPlayers]uint64{}
+	pieceCounts := [NumPlayers]int{}
+
+	for column := range solverWidth {
+		for row := range solverHeight {
+			cell := uint64(1) << (column*(solverHeight+1) + (solverHeight - 1 - row))
+
+			switch owner := gba.GetSpaceOwnership(column, row); owner {
+			case NoPlayer:
+				continue
+			case 1, 2:
+				playerPieces[owner-1] |= cell
+				pieceCounts[owner-1]++
+			default:
+				return position, fmt.Errorf("column %d row %d is owned by %d, the solver expects players 1 and 2", column, row, owner)
+			}
+		}
+	}
+
+	position.mask = playerPieces[0] | playerPieces[1]
+	position.moves = pieceCounts[0] + pieceCounts[1]
+
+	for column := range solverWidth {
+		columnPieces := position.mask & solverColumnMask(column)
+		if columnPieces&(columnPieces+solverBottomMask(column)) != 0 {
+			return position, fmt.Errorf("column %d has a piece floating above an empty space", column)
+		}
+	}
+
+	switch pieceCounts[0] - pieceCounts[1] {
+	case 0:
+		position.current = playerPieces[0]
+	case 1:
+		position.current = playerPieces[1]
+	default:
+		return position, fmt.Errorf("player 1 has %d pieces and player 2 has %d", pieceCounts[0], pieceCounts[1])
+	}
+
+	if solverHasConnection(playerPieces[0]) || solverHasConnection(playerPieces[1]) {
+		return position, errors.New("the game is already over")
+	}
+
+	return position, nil
+}
+
+func solverHasConnection(pieces uint64) bool {
+	for _, shift := range [4]int{1, solverHeight + 1, solverHeight, solverHeight + 2} {
+		pair := pieces & (pieces >> shift)
+		if pair&(pair>>(2*shift)) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SolveMoves solves the position reached by a move string of columns numbered 1 to 7, like "4453"
+func (solver *Solver) SolveMoves(moves string) (SolvedPosition, error) {
+	position, err := newSolverPositionFromMoves(moves)
+	if err != nil {
+		return SolvedPosition{}, err
+	}
+
+	return solver.solvePosition(position), nil
+}
+
+// SolveMatrix solves a matrix laid out for NewInProgressGameBoard
+func (solver *Solver) SolveMatrix(matrix [BoardHeight][BoardWidth]int) (SolvedPosition, error) {
+	rows := make([][]int, len(matrix))
+	for ndx := range matrix {
+		rows[ndx] = matrix[ndx][:]
+	}
+
+	// an impossible matrix is reported as an error rather than a panic
+	return solver.SolveGameBoard(newUncheckedInProgressGameBoard(rows, WinningLength))
+}
+
+// SolveGameBoard solves a standard board where player 1 moved first and player 2 second
+func (solver *Solver) SolveGameBoard(gba GameBoardActions) (SolvedPosition, error) {
+	position, err := newSolverPositionFromActions(gba)
+	if err != nil {
+		return SolvedPosition{}, err
+	}
+
+	return solver.solvePosition(position), nil
+}
+
+// ScoreColumnsForMoves scores every column of the position reached by a move string
+func (solver *Solver) ScoreColumnsForMoves(moves string) ([]ColumnScore, error) {
+	position, err := newSolverPositionFromMoves(moves)
+	if err != nil {
+		return nil, err
+	}
+
+	return solver.scoreColumns(position), nil
+}
+
+// ScoreColumns scores every column of a standard board where player 1 moved first and player 2 second
+func (solver *Solver) ScoreColumns(gba GameBoardActions) ([]ColumnScore, error) {
+	position, err := newSolverPositionFromActions(gba)
+	if err != nil {
+		return nil, err
+	}
+
+	return solver.scoreColumns(position), nil
+}
+
+// BestColumns lists every column that keeps the best result, a strategy that picks one of them plays perfectly
+func (solver *Solver) BestColumns(gba GameBoardActions) ([]int, error) {
+	columnScores, err := solver.ScoreColumns(gba)
+	if err != nil {
+		return nil, err
+	}
+
+	bestColumns := []int{}
+	bestScore := solverMinScore - 1
+	for _, columnScore := range columnScores {
+		if !columnScore.Playable {
+			continue
+		}
+
+		if columnScore.Result.Score > bestScore {
+			bestScore = columnScore.Result.Score
+			bestColumns = []int{}
+		}
+		if columnScore.Result.Score == bestScore {
+			bestColumns = append(bestColumns, columnScore.Column)
+		}
+	}
+
+	return bestColumns, nil
+}
+
+func (solver *Solver) scoreColumns(position solverPosition) []ColumnScore {
+	columnScores := make([]ColumnScore, solverWidth)
+
+	for column := range solverWidth {
+		columnScores[column].Column = column
+		if !position.canPlay(column) {
+			continue
+		}
+		columnScores[column].Playable = true
+
+		if position.isWinningColumn(column) {
+			columnScores[column].Result = newSolvedPosition((solverSpaces+1-position.moves)/2, position.moves)
+			continue
+		}
+
+		next := position
+		next.playColumn(column)
+		opponentResult := solver.solvePosition(next)
+		columnScores[column].Result = newSolvedPosition(-opponentResult.Score, position.moves)
+	}
+
+	return columnScores
+}
+
+func newSolvedPosition(score int, moves int) SolvedPosition {
+	solved := SolvedPosition{Score: score}
+
+	// the player to move has played moves/2 pieces and their opponent (moves+1)/2
+	switch {
+	case score > 0:
+		winningPiece := (solverSpaces+1)/2 + 1 - score
+		solved.Outcome = SolverWin
+		solved.MovesToEnd = 2*(winningPiece-moves/2) - 1
+	case score < 0:
+		winningPiece := (solverSpaces+1)/2 + 1 + score
+		solved.Outcome = SolverLoss
+		solved.MovesToEnd = 2 * (winningPiece - (moves+1)/2)
+	default:
+		solved.Outcome = SolverDraw
+		solved.MovesToEnd = solverSpaces - moves
+	}
+
+	return solved
+}
+
+// solvePosition narrows the score window with null window searches until the exact score is known
+func (solver *Solver) solvePosition(position solverPosition) SolvedPosition {
+	if position.canWinNext() {
+		return newSolvedPosition((solverSpaces+1-position.moves)/2, position.moves)
+	}
+
+	minScore := -(solverSpaces - position.moves) / 2
+	maxScore := (solverSpaces + 1 - position.moves) / 2
+
+	for minScore < maxScore {
+		median := minScore + (maxScore-minScore)/2
+		if median <= 0 && minScore/2 < median {
+			median = minScore / 2
+		} else if median >= 0 && maxScore/2 > median {
+			median = maxScore / 2
+		}
+
+		score := solver.negamax(position, median, median+1)
+		if score <= median {
+			maxScore = score
+		} else {
+			minScore = score
+		}
+	}
+
+	return newSolvedPosition(minScore, position.moves)
+}
+
+type solverMove struct {
+	move  uint64
+	score int
+}
+
+func (solver *Solver) negamax(position solverPosition, alpha int, beta int) int {
+	solver.Nodes++
+
+	nonLosingMoves := position.possibleNonLosingMoves()
+	if nonLosingMoves == 0 {
+		return -(solverSpaces - position.moves) / 2
+	}
+
+	if position.moves >= solverSpaces-2 {
+		return 0
+	}
+
+	lowerBound := -(solverSpaces - 2 - position.moves) / 2
+	if alpha < lowerBound {
+		alpha = lowerBound
+		if alpha >= beta {
+			return alpha
+		}
+	}
+
+	upperBound := (solverSpaces - 1 - position.moves) / 2
+	key := position.key()
+	if value := solver.lookup(key); value != 0 {
+		if value > solverMaxScore-solverMinScore+1 {
+			lowerBound = value + 2*solverMinScore - solverMaxScore - 2
+			if alpha < lowerBound {
+				alpha = lowerBound
+				if alpha >= beta {
+					return alpha
+				}
+			}
+		} else {
+			upperBound = value + solverMinScore - 1
+		}
+	}
+	if beta > upperBound {
+		beta = upperBound
+		if alpha >= beta {
+			return beta
+		}
+	}
+
+	moves := make([]solverMove, 0, solverWidth)
+	for _, column := range CenterFirstColumnOrder(solverWidth) {
+		if move := nonLosingMoves & solverColumnMask(column); move != 0 {
+			moves = append(moves, solverMove{move: move, score: position.moveScore(move)})
+		}
+	}
+	sort.SliceStable(moves, func(i, j int) bool {
+		return moves[i].score > moves[j].score
+	})
+
+	for _, move := range moves {
+		next := position
+		next.play(move.move)
+
+		score := -solver.negamax(next, -beta, -alpha)
+		if score >= beta {
+			solver.store(key, score+solverMaxScore-2*solverMinScore+2)
+			return score
+		}
+		alpha = max(alpha, score)
+	}
+
+	solver.store(key, alpha-solverMinScore+1)
+	return alpha
+}
+
+func (solver *Solver) lookup(key uint64) int {
+	ndx := key % uint64(solverTableSize)
+	if solver.keys[ndx] == key {
+		return int(solver.values[ndx])
+	}
+
+	return 0
+}
+
+func (solver *Solver) store(key uint64, value int) {
+	ndx := key % uint64(solverTableSize)
+	solver.keys[ndx] = key
+	solver.values[ndx] = int8(value)
+}
@@ -0,0 +1,174 @@
+package game
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// sharedSolver avoids allocating a new transposition table in every test
+var sharedSolver = NewSolver()
+
+func TestSolveMovesImmediateWin(t *testing.T) {
+	solved, err := sharedSolver.SolveMoves("121212")
+	if err != nil {
+		t.Fatalf(`TestSolveMovesImmediateWin returned error %v`, err)
+	}
+
+	expected := SolvedPosition{Score: 18, Outcome: SolverWin, MovesToEnd: 1}
+	if solved != expected {
+		t.Errorf(`TestSolveMovesImmediateWin expected %+v but got %+v`, expected, solved)
+	}
+}
+
+func TestSolveMovesForcedLoss(t *testing.T) {
+	// player 1 has an open three on the bottom row that player 2 can only block on one side
+	solved, err := sharedSolver.SolveMoves("22334")
+	if err != nil {
+		t.Fatalf(`TestSolveMovesForcedLoss returned error %v`, err)
+	}
+
+	if solved.Outcome != SolverLoss || solved.MovesToEnd != 2 {
+		t.Errorf(`TestSolveMovesForcedLoss expected a loss in 2 moves but got %+v`, solved)
+	}
+}
+
+func TestSolveMovesRejectsInvalidMoves(t *testing.T) {
+	for _, moves := range []string{"8", "0", "1111111", "1212121"} {
+		if _, err := sharedSolver.SolveMoves(moves); err == nil {
+			t.Errorf(`TestSolveMovesRejectsInvalidMoves expected %q to be rejected`, moves)
+		}
+	}
+}
+
+func TestSolveMatrixMatchesMoves(t *testing.T) {
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{1, 2, -1, -1, -1, -1, -1},
+		{1, 2, -1, -1, -1, -1, -1},
+		{1, 2, -1, -1, -1, -1, -1},
+	}
+
+	solvedMatrix, err := sharedSolver.SolveMatrix(thisBoard)
+	if err != nil {
+		t.Fatalf(`TestSolveMatrixMatchesMoves returned error %v`, err)
+	}
+	solvedMoves, _ := sharedSolver.SolveMoves("121212")
+
+	if solvedMatrix != solvedMoves {
+		t.Errorf(`TestSolveMatrixMatchesMoves expected %+v but got %+v`, solvedMoves, solvedMatrix)
+	}
+}
+
+func TestSolveMatrixRejectsFloatingPieces(t *testing.T) {
+	thisBoard := [BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 2, -1, -1, -1},
+	}
+
+	if _, err := sharedSolver.SolveMatrix(thisBoard); err == nil {
+		t.Errorf(`TestSolveMatrixRejectsFloatingPieces expected an error`)
+	}
+}
+
+func TestSolverRejectsOtherBoardSizes(t *testing.T) {
+	if _, err := sharedSolver.SolveGameBoard(NewGameBoardOfSize(8, 7, WinningLength)); err == nil {
+		t.Errorf(`TestSolverRejectsOtherBoardSizes expected an 8x7 board to be rejected`)
+	}
+}
+
+func TestScoreColumnsAndBestColumns(t *testing.T) {
+	// player 1 owns the bottom, third and fifth rows of columns 1 to 3 and can finish any of them in column 4
+	columnScores, err := sharedSolver.ScoreColumnsForMoves("111111222222333333")
+	if err != nil {
+		t.Fatalf(`TestScoreColumnsAndBestColumns returned error %v`, err)
+	}
+
+	if columnScores[3].Result.Outcome != SolverWin || columnScores[3].Result.MovesToEnd != 1 {
+		t.Errorf(`TestScoreColumnsAndBestColumns expected column 3 to win straight away but got %+v`, columnScores[3])
+	}
+	for _, columnScore := range columnScores {
+		if columnScore.Column != 3 && columnScore.Result.Score >= columnScores[3].Result.Score {
+			t.Errorf(`TestScoreColumnsAndBestColumns expected column %d to score below column 3 but got %+v`, columnScore.Column, columnScore)
+		}
+	}
+
+	gameBoard := NewGameBoard()
+	for turn := range 18 {
+		gameBoard.PlayPiece(turn%NumPlayers+1, turn/BoardHeight+1)
+	}
+	bestColumns, err := sharedSolver.BestColumns(gameBoard)
+	if err != nil {
+		t.Fatalf(`TestScoreColumnsAndBestColumns returned error %v`, err)
+	}
+	if !reflect.DeepEqual(bestColumns, []int{0, 4}) {
+		t.Errorf(`TestScoreColumnsAndBestColumns expected columns 0 and 4 to be best but got %v`, bestColumns)
+	}
+}
+
+func TestScoreColumnsMarksFullColumnsUnplayable(t *testing.T) {
+	columnScores, err := sharedSolver.ScoreColumnsForMoves("111111222222333333")
+	if err != nil {
+		t.Fatalf(`TestScoreColumnsMarksFullColumnsUnplayable returned error %v`, err)
+	}
+
+	if columnScores[0].Playable {
+		t.Errorf(`TestScoreColumnsMarksFullColumnsUnplayable expected column 0 to be full`)
+	}
+}
+
+// bruteForceScore scores a position by searching every move without pruning
+func bruteForceScore(position solverPosition) int {
+	if position.moves == solverSpaces {
+		return 0
+	}
+
+	for column := range solverWidth {
+		if position.canPlay(column) && position.isWinningColumn(column) {
+			return (solverSpaces + 1 - position.moves) / 2
+		}
+	}
+
+	best := -solverSpaces
+	for column := range solverWidth {
+		if position.canPlay(column) {
+			next := position
+			next.playColumn(column)
+			best = max(best, -bruteForceScore(next))
+		}
+	}
+
+	return best
+}
+
+func TestSolverAgreesWithBruteForceNearTheEnd(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+
+	for game := 0; game < 20; game++ {
+		moves := ""
+		for attempts := 0; len(moves) < solverSpaces-12 && attempts < 1000; attempts++ {
+			candidate := moves + strconv.Itoa(rng.Intn(solverWidth)+1)
+			if _, err := newSolverPositionFromMoves(candidate); err == nil {
+				moves = candidate
+			}
+		}
+
+		position, _ := newSolverPositionFromMoves(moves)
+		solved, err := sharedSolver.SolveMoves(moves)
+		if err != nil {
+			t.Fatalf(`TestSolverAgreesWithBruteForceNearTheEnd returned error %v for %s`, err, moves)
+		}
+
+		expected := bruteForceScore(position)
+		if solved.Score != expected {
+			t.Errorf(`TestSolverAgreesWithBruteForceNearTheEnd %s expected score %d but got %d`, moves, expected, solved.Score)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// StrategyPanicError is a panic recovered from a PlayerStrategy while it chose a move
+//
+// The player who panicked forfeits the game, and the rest of the run carries on.
+type StrategyPanicError struct {
+	PlayerValue  int
+	StrategyName string
+	Value        any    // the value passed to panic
+	Stack        string // the stack of the goroutine that panicked
+}
+
+func (e *StrategyPanicError) Error() string {
+	return fmt.Sprintf("%s panicked: %v", e.StrategyName, e.Value)
+}
+
+// moveChoice is the answer from one call to a PlayerStrategy
+type moveChoice struct {
+	column int
+	err    error
+}
+
+// callStrategy asks the player for a move, passing ctx to a ContextPlayerStrategy when ctx is not nil
+// A panic in the strategy is returned as a *StrategyPanicError
+func callStrategy(ctx context.Context, player PlayerStrategy, gameBoard BoardView) (choice moveChoice) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			choice = moveChoice{
+				column: StatusNoAvailableMove,
+				err: &StrategyPanicError{
+					PlayerValue:  player.GetPlayerValue(),
+					StrategyName: player.GetName(),
+					Value:        recovered,
+					Stack:        string(debug.Stack()),
+				},
+			}
+		}
+	}()
+
+	if contextPlayer, ok := player.(ContextPlayerStrategy); ok && ctx != nil {
+		return moveChoice{column: contextPlayer.PlayerChoosesAMoveWithContext(ctx, gameBoard)}
+	}
+
+	return moveChoice{column: player.PlayerChoosesAMove(gameBoard)}
+}
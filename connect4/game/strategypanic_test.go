@@ -0,0 +1,56 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	Register("testpanicky", NewPlayerStrategyPanicky)
+}
+
+// PlayerStrategyPanicky reads a space that isn't on the board
+type PlayerStrategyPanicky struct {
+	PlayerStrategyFirstAvailableMove
+}
+
+func NewPlayerStrategyPanicky(playerValue int) PlayerStrategy {
+	return &PlayerStrategyPanicky{PlayerStrategyFirstAvailableMove{name: "Panicky Strategy", playerValue: playerValue}}
+}
+
+func (p PlayerStrategyPanicky) PlayerChoosesAMove(gameBoard BoardView) int {
+	return gameBoard.GetSpaceOwnership(-1, 0)
+}
+
+func TestStrategyPanicForfeits(t *testing.T) {
+	for _, moveTimeBudget := range []time.Duration{0, time.Second} {
+		config := NewDefaultGameConfig()
+		config.Player1 = "firstavailable"
+		config.Player2 = "testpanicky"
+		config.MoveTimeBudget = moveTimeBudget
+
+		result := PlayConnect4(config)
+		winner, message := result.Winner, result.Message()
+
+		if winner != 1 || !strings.Contains(message, "Player 2 Panicky Strategy forfeits after a panic") {
+			t.Errorf(`TestStrategyPanicForfeits %v expected player 2 to forfeit but got %d: %v`, moveTimeBudget, winner, message)
+		}
+		if !strings.Contains(message, "index out of range") || !strings.Contains(message, "PlayerStrategyPanicky") {
+			t.Errorf(`TestStrategyPanicForfeits %v expected the panic and its stack trace but got %v`, moveTimeBudget, message)
+		}
+	}
+}
+
+func TestCallStrategyRecoversPanic(t *testing.T) {
+	choice := callStrategy(nil, NewPlayerStrategyPanicky(2), NewBoardView(NewGameBoard(), 2, 1))
+
+	var panicErr *StrategyPanicError
+	if !errors.As(choice.err, &panicErr) {
+		t.Fatalf(`TestCallStrategyRecoversPanic expected a StrategyPanicError but got %v`, choice.err)
+	}
+	if choice.column != StatusNoAvailableMove || panicErr.PlayerValue != 2 || panicErr.StrategyName != "Panicky Strategy" {
+		t.Errorf(`TestCallStrategyRecoversPanic attributed the panic to player %d %v with column %d`, panicErr.PlayerValue, panicErr.StrategyName, choice.column)
+	}
+}
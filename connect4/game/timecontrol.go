@@ -0,0 +1,160 @@
+package game
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const TimeoutPolicyForfeit string = "forfeit"
+const TimeoutPolicyRandomMove string = "random"
+const TimeoutPolicyFirstAvailable string = "firstavailable"
+
+// ContextPlayerStrategy is a PlayerStrategy that stops thinking when its context is done
+//
+// When the game has a time control the engine calls PlayerChoosesAMoveWithContext instead of PlayerChoosesAMove,
+// with a context that expires when the player's time for the move is up.
+// A strategy should return its best move so far once ctx.Done() is closed.
+type ContextPlayerStrategy interface {
+	PlayerStrategy
+	PlayerChoosesAMoveWithContext(ctx context.Context, gameBoard BoardView) int
+}
+
+// playerClock tracks the time a player has left when the game has a total clock
+type playerClock struct {
+	remaining time.Duration
+}
+
+// moveDeadline is the time the current move must be made by, zero when the move has no limit
+func moveDeadline(config GameConfig, clock playerClock, start time.Time) time.Time {
+	deadline := time.Time{}
+
+	if config.MoveTimeBudget > 0 {
+		deadline = start.Add(config.MoveTimeBudget)
+	}
+
+	if config.TotalClock > 0 {
+		clockDeadline := start.Add(max(0, clock.remaining))
+		if deadline.IsZero() || clockDeadline.Before(deadline) {
+			deadline = clockDeadline
+		}
+	}
+
+	return deadline
+}
+
+// strategyRunner asks one player for its moves and remembers a call that overran its deadline
+type strategyRunner struct {
+	player        PlayerStrategy
+	opponentValue int
+	pending       chan moveChoice
+	rng           *rand.Rand // chooses the random moves played when the player runs out of time
+	interactive   bool       // the player is a person, who may take back moves and resign
+	takeBacks     int        // how many moves the player has taken back this game
+}
+
+// chooseMoveFor asks the player for a move within its time control and charges the time taken to its clock
+func (runner *strategyRunner) chooseMoveFor(config GameConfig, clock *playerClock, gameBoard PlayableGameBoard) (int, bool, error) {
+	start := time.Now()
+	column, timedOut, err := runner.chooseMoveBefore(moveDeadline(config, *clock, start), gameBoard)
+	clock.remaining -= time.Since(start)
+
+	return column, timedOut, err
+}
+
+// chooseMoveBefore asks the player for a move and reports whether it missed the deadline
+// A panic in the strategy, including one in a call that overran an earlier deadline, is returned as the error
+//
+// The player always thinks on a BoardView of a Snapshot of the board, so that nothing it does can change the engine's board
+// and a strategy that overruns its deadline never sees the engine's board change underneath it.
+// A strategy that ignores its context keeps running in the background, and is not asked for
+// another move until that call returns, so a strategy is never running twice at once.
+func (runner *strategyRunner) chooseMoveBefore(deadline time.Time, gameBoard PlayableGameBoard) (int, bool, error) {
+	if runner.pending != nil {
+		returned, err := runner.waitForPending(deadline)
+		if err != nil {
+			return StatusNoAvailableMove, false, err
+		}
+		if !returned {
+			return StatusNoAvailableMove, true, nil
+		}
+	}
+
+	snapshot := NewBoardView(gameBoard.Snapshot(), runner.player.GetPlayerValue(), runner.opponentValue)
+
+	if deadline.IsZero() {
+		choice := callStrategy(nil, runner.player, snapshot)
+		return choice.column, false, choice.err
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	choices := make(chan moveChoice, 1)
+
+	go func() {
+		choices <- callStrategy(ctx, runner.player, snapshot)
+	}()
+
+	select {
+	case choice := <-choices:
+		return choice.column, false, choice.err
+	case <-ctx.Done():
+		runner.pending = choices
+		return StatusNoAvailableMove, true, nil
+	}
+}
+
+// waitForPending waits until the deadline for an overrunning call to return and reports whether it did,
+// along with any panic from that call
+func (runner *strategyRunner) waitForPending(deadline time.Time) (bool, error) {
+	if deadline.IsZero() {
+		choice := <-runner.pending
+		runner.pending = nil
+		return true, choice.err
+	}
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case choice := <-runner.pending:
+		runner.pending = nil
+		return true, choice.err
+	case <-timer.C:
+		return false, nil
+	}
+}
+
+// timeoutSubstitute picks the move played for a player who ran out of time
+func timeoutSubstitute(policy string, playerValue int, gameBoard GameBoardActions, rng *rand.Rand) int {
+	if policy == TimeoutPolicyRandomMove {
+		return randomLegalColumn(gameBoard, rng)
+	}
+
+	return firstAvailableColumn(gameBoard)
+}
+
+func randomLegalColumn(gameBoard GameBoardActions, rng *rand.Rand) int {
+	columns := legalColumns(gameBoard)
+	if len(columns) == 0 {
+		return StatusNoAvailableMove
+	}
+
+	return columns[rng.Intn(len(columns))]
+}
+
+// ValidateTimeControl reports time control settings PlayConnect4 cannot use
+func (config GameConfig) ValidateTimeControl() error {
+	if config.MoveTimeBudget < 0 || config.TotalClock < 0 || config.ClockIncrement < 0 {
+		return fmt.Errorf("time controls cannot be negative")
+	}
+
+	switch config.TimeoutPolicy {
+	case TimeoutPolicyForfeit, TimeoutPolicyRandomMove, TimeoutPolicyFirstAvailable, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown timeout policy %q, expected %s, %s or %s", config.TimeoutPolicy, TimeoutPolicyForfeit, TimeoutPolicyRandomMove, TimeoutPolicyFirstAvailable)
+	}
+}
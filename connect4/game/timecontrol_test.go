@@ -0,0 +1,168 @@
+package game
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	Register("testsleepy", NewPlayerStrategySleepy)
+	Register("testpatient", NewPlayerStrategyPatient)
+}
+
+// PlayerStrategySleepy ignores the clock and thinks for 50ms about every move
+type PlayerStrategySleepy struct {
+	PlayerStrategyFirstAvailableMove
+}
+
+func NewPlayerStrategySleepy(playerValue int) PlayerStrategy {
+	return &PlayerStrategySleepy{PlayerStrategyFirstAvailableMove{name: "Sleepy Strategy", playerValue: playerValue}}
+}
+
+func (p PlayerStrategySleepy) PlayerChoosesAMove(gameBoard BoardView) int {
+	time.Sleep(50 * time.Millisecond)
+	return p.PlayerStrategyFirstAvailableMove.PlayerChoosesAMove(gameBoard)
+}
+
+// PlayerStrategyPatient uses all of its time and records the deadline it was given
+type PlayerStrategyPatient struct {
+	PlayerStrategyFirstAvailableMove
+	deadlines []time.Time
+}
+
+func NewPlayerStrategyPatient(playerValue int) PlayerStrategy {
+	return &PlayerStrategyPatient{PlayerStrategyFirstAvailableMove: PlayerStrategyFirstAvailableMove{name: "Patient Strategy", playerValue: playerValue}}
+}
+
+func (p *PlayerStrategyPatient) PlayerChoosesAMoveWithContext(ctx context.Context, gameBoard BoardView) int {
+	deadline, _ := ctx.Deadline()
+	p.deadlines = append(p.deadlines, deadline)
+	<-ctx.Done()
+
+	return p.PlayerStrategyFirstAvailableMove.PlayerChoosesAMove(gameBoard)
+}
+
+func TestSlowStrategyForfeitsWithForfeitPolicy(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "testsleepy"
+	config.Player2 = "firstavailable"
+	config.MoveTimeBudget = 5 * time.Millisecond
+	config.TimeoutPolicy = TimeoutPolicyForfeit
+
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 2 || !strings.Contains(message, "ran out of time") {
+		t.Errorf(`TestSlowStrategyForfeitsWithForfeitPolicy expected player 2 to win on time but got %d: %v`, winner, message)
+	}
+}
+
+func TestSlowStrategyGetsSubstituteMoves(t *testing.T) {
+	for _, policy := range []string{TimeoutPolicyFirstAvailable, TimeoutPolicyRandomMove} {
+		config := NewDefaultGameConfig()
+		config.Player1 = "testsleepy"
+		config.Player2 = "testsleepy"
+		config.MoveTimeBudget = 2 * time.Millisecond
+		config.TimeoutPolicy = policy
+
+		result := PlayConnect4(config)
+		winner, message := result.Winner, result.Message()
+
+		if strings.Contains(message, "ran out of time") {
+			t.Errorf(`TestSlowStrategyGetsSubstituteMoves %s expected the game to be played out but got %d: %v`, policy, winner, message)
+		}
+	}
+}
+
+func TestContextStrategyReceivesTheMoveDeadline(t *testing.T) {
+	player := NewPlayerStrategyPatient(1).(*PlayerStrategyPatient)
+	runner := strategyRunner{player: player}
+	deadline := time.Now().Add(10 * time.Millisecond)
+
+	column, timedOut, _ := runner.chooseMoveBefore(deadline, NewGameBoard())
+	runner.waitForPending(time.Time{})
+
+	if !timedOut || column != StatusNoAvailableMove {
+		t.Errorf(`TestContextStrategyReceivesTheMoveDeadline expected the patient strategy to time out but got column %d`, column)
+	}
+	if len(player.deadlines) != 1 || !player.deadlines[0].Equal(deadline) {
+		t.Errorf(`TestContextStrategyReceivesTheMoveDeadline expected the deadline %v but got %v`, deadline, player.deadlines)
+	}
+}
+
+func TestTotalClockRunsOut(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "testsleepy"
+	config.TotalClock = 120 * time.Millisecond
+	config.TimeoutPolicy = TimeoutPolicyForfeit
+
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 1 || !strings.Contains(message, "ran out of time") {
+		t.Errorf(`TestTotalClockRunsOut expected player 1 to win on time but got %d: %v`, winner, message)
+	}
+}
+
+func TestClockIncrementKeepsSlowStrategyInTheGame(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "testsleepy"
+	config.TotalClock = 120 * time.Millisecond
+	config.ClockIncrement = 100 * time.Millisecond
+	config.TimeoutPolicy = TimeoutPolicyForfeit
+
+	result := PlayConnect4(config)
+	winner, message := result.Winner, result.Message()
+
+	if winner != 1 || strings.Contains(message, "ran out of time") {
+		t.Errorf(`TestClockIncrementKeepsSlowStrategyInTheGame expected player 1 to win on the board but got %d: %v`, winner, message)
+	}
+}
+
+func TestMoveDeadlineIsTheEarlierOfBudgetAndClock(t *testing.T) {
+	config := NewDefaultGameConfig()
+	start := time.Now()
+
+	if deadline := moveDeadline(config, playerClock{}, start); !deadline.IsZero() {
+		t.Errorf(`TestMoveDeadlineIsTheEarlierOfBudgetAndClock expected no deadline without a time control but got %v`, deadline)
+	}
+
+	config.MoveTimeBudget = time.Second
+	config.TotalClock = time.Minute
+	if deadline := moveDeadline(config, playerClock{remaining: 300 * time.Millisecond}, start); !deadline.Equal(start.Add(300 * time.Millisecond)) {
+		t.Errorf(`TestMoveDeadlineIsTheEarlierOfBudgetAndClock expected the clock to limit the move but got %v`, deadline.Sub(start))
+	}
+	if deadline := moveDeadline(config, playerClock{remaining: time.Minute}, start); !deadline.Equal(start.Add(time.Second)) {
+		t.Errorf(`TestMoveDeadlineIsTheEarlierOfBudgetAndClock expected the budget to limit the move but got %v`, deadline.Sub(start))
+	}
+}
+
+func TestValidateTimeControlRejectsUnknownPolicy(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.TimeoutPolicy = "panic"
+
+	if err := config.ValidateTimeControl(); err == nil {
+		t.Errorf(`TestValidateTimeControlRejectsUnknownPolicy expected an unknown policy to be rejected`)
+	}
+}
+
+func TestSearchStrategiesStopWhenTheContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for _, player := range []PlayerStrategy{NewPlayerStrategyNegamaxWithDepth(1, 40), NewPlayerStrategyMCTS(1)} {
+		start := time.Now()
+		column := player.(ContextPlayerStrategy).PlayerChoosesAMoveWithContext(ctx, newTestBoardView(NewGameBoard(), player.GetPlayerValue()))
+
+		if time.Since(start) > time.Second {
+			t.Errorf(`TestSearchStrategiesStopWhenTheContextIsDone %s kept searching after its context was done`, player.GetName())
+		}
+		if column < 0 || column >= BoardWidth {
+			t.Errorf(`TestSearchStrategiesStopWhenTheContextIsDone %s expected a legal column but got %d`, player.GetName(), column)
+		}
+	}
+}
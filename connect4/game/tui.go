@@ -0,0 +1,340 @@
+package game
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// TerminalUIFrameDelay is how long each row of a falling piece is shown
+const TerminalUIFrameDelay = 40 * time.Millisecond
+
+const (
+	ansiClearScreen = "\x1b[H\x1b[2J"
+	ansiReset       = "\x1b[0m"
+	ansiDim         = "\x1b[2m"
+	ansiBold        = "\x1b[1m"
+	ansiHighlight   = "\x1b[1;7m"
+)
+
+// discColors are the colours of the first and second player's discs, red and yellow
+var discColors = [NumPlayers]string{"\x1b[31m", "\x1b[33m"}
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;?]*[A-Za-z]")
+
+// TerminalUI draws the game full screen with ANSI escape codes
+//
+// Each frame shows the board with coloured discs, a cursor over the column a human player is choosing,
+// the list of moves beside the board and the players' clocks underneath. A new piece falls into place
+// and the winning lines are highlighted when the game is won.
+type TerminalUI struct {
+	mu           sync.Mutex
+	output       io.Writer
+	frameDelay   time.Duration // zero draws a move without the falling piece
+	playerValues [NumPlayers]int
+	playerNames  [NumPlayers]string
+	clocks       [NumPlayers]time.Duration
+	clockLabel   string
+}
+
+// terminalBoard is what the terminal UI reads from both the engine's board and a player's BoardView
+type terminalBoard interface {
+	boardReader
+	GetTurnHistory() []RecordedTurn
+}
+
+// fallingPiece is a piece drawn on its way down to the row it was played in
+type fallingPiece struct {
+	turn RecordedTurn
+	row  int
+}
+
+func NewTerminalUI(output io.Writer, frameDelay time.Duration) *TerminalUI {
+	return &TerminalUI{
+		output:       output,
+		frameDelay:   frameDelay,
+		playerValues: [NumPlayers]int{1, 2},
+		clockLabel:   "used",
+	}
+}
+
+// TerminalSupportsColor reports whether output is a terminal that can show the TerminalUI
+// Setting NO_COLOR or TERM=dumb asks for the plain board instead.
+func TerminalSupportsColor(output io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	if term := os.Getenv("TERM"); term == "" || term == "dumb" {
+		return false
+	}
+
+	return isTerminal(output)
+}
+
+func isTerminal(stream any) bool {
+	file, isFile := stream.(*os.File)
+	if !isFile {
+		return false
+	}
+
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// cbreakStdin switches the terminal to deliver each key as it is pressed, without echoing it
+// The returned function puts the terminal back the way it was.
+func cbreakStdin() (func(), error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := stty("cbreak", "-echo"); err != nil {
+		return nil, err
+	}
+
+	return func() { stty(strings.TrimSpace(saved)) }, nil
+}
+
+func stty(args ...string) (string, error) {
+	command := exec.Command("stty", args...)
+	command.Stdin = os.Stdin
+	output, err := command.Output()
+	return string(output), err
+}
+
+func (ui *TerminalUI) startGame(playerValues [NumPlayers]int, playerNames [NumPlayers]string) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	ui.playerValues = playerValues
+	ui.playerNames = playerNames
+}
+
+// setClocks shows the time each player has left, or has used when the game has no clock
+func (ui *TerminalUI) setClocks(config GameConfig, clocks [NumPlayers]playerClock, thinkTimes []time.Duration) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	if config.TotalClock > 0 {
+		ui.clockLabel = "left"
+		for playerNdx := range NumPlayers {
+			ui.clocks[playerNdx] = max(0, clocks[playerNdx].remaining)
+		}
+		return
+	}
+
+	ui.clockLabel = "used"
+	ui.clocks = [NumPlayers]time.Duration{}
+	for ndx, thinkTime := range thinkTimes {
+		ui.clocks[ndx%NumPlayers] += thinkTime
+	}
+}
+
+// showMove drops the last piece played down its column, one row per frame, then draws the board
+func (ui *TerminalUI) showMove(gameBoard terminalBoard) {
+	turnHistory := gameBoard.GetTurnHistory()
+	if ui.frameDelay > 0 && len(turnHistory) > 0 {
+		last := turnHistory[len(turnHistory)-1]
+		for row := range last.Row {
+			ui.draw(gameBoard, NoPlayer, "", &fallingPiece{turn: last, row: row})
+			time.Sleep(ui.frameDelay)
+		}
+	}
+
+	ui.draw(gameBoard, NoPlayer, "", nil)
+}
+
+// showBoard draws the board with the cursor over a column, NoPlayer for no cursor, and a message under it
+func (ui *TerminalUI) showBoard(gameBoard terminalBoard, cursor int, message string) {
+	ui.draw(gameBoard, cursor, message, nil)
+}
+
+func (ui *TerminalUI) draw(gameBoard terminalBoard, cursor int, message string, falling *fallingPiece) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+
+	turnHistory := gameBoard.GetTurnHistory()
+	width := gameBoard.GetWidth()
+	winningCells := map[Cell]bool{}
+	if falling == nil {
+		winningCells = winningCellSet(gameBoard.WinningLines())
+	}
+
+	header := "Connect " + fmt.Sprint(gameBoard.GetWinningLength())
+	for playerNdx := range NumPlayers {
+		header += fmt.Sprintf("   %s Player %d %s", ui.disc(ui.playerValues[playerNdx], false), ui.playerValues[playerNdx], ui.playerNames[playerNdx])
+	}
+
+	cursorLine := " "
+	for column := range width {
+		if column == cursor {
+			cursorLine += " " + ui.colorOf(ui.moverValue(turnHistory)) + ansiBold + "v" + ansiReset + " "
+		} else {
+			cursorLine += "   "
+		}
+	}
+
+	boardLines := []string{cursorLine}
+	for row := range gameBoard.GetHeight() {
+		line := "|"
+		for column := range width {
+			owner := gameBoard.GetSpaceOwnership(column, row)
+			// the piece is drawn on its way down in place of where it lands
+			if falling != nil && column == falling.turn.Column {
+				switch row {
+				case falling.row:
+					owner = falling.turn.PlayerValue
+				case falling.turn.Row:
+					owner = NoPlayer
+				}
+			}
+			line += " " + ui.disc(owner, winningCells[Cell{Column: column, Row: row}]) + " "
+		}
+		boardLines = append(boardLines, line+"|")
+	}
+	boardLines = append(boardLines, "+"+strings.Repeat("---", width)+"+")
+
+	numbers := " "
+	for column := range width {
+		numbers += fmt.Sprintf("%2d ", column+1)
+	}
+	boardLines = append(boardLines, numbers)
+
+	var frame strings.Builder
+	frame.WriteString(ansiClearScreen)
+	fmt.Fprintln(&frame, header)
+	fmt.Fprintln(&frame)
+
+	moveList := ui.moveList(turnHistory, len(boardLines))
+	boardWidth := 3*width + 2
+	for ndx, line := range boardLines {
+		fmt.Fprint(&frame, line)
+		if ndx < len(moveList) {
+			fmt.Fprint(&frame, strings.Repeat(" ", max(0, boardWidth-visibleWidth(line)))+"   "+moveList[ndx])
+		}
+		fmt.Fprintln(&frame)
+	}
+
+	fmt.Fprintln(&frame)
+	fmt.Fprintf(&frame, "Clock (%s)", ui.clockLabel)
+	for playerNdx := range NumPlayers {
+		fmt.Fprintf(&frame, "   %s Player %d %s", ui.disc(ui.playerValues[playerNdx], false), ui.playerValues[playerNdx], formatClock(ui.clocks[playerNdx]))
+	}
+	fmt.Fprintln(&frame)
+
+	if message != "" {
+		fmt.Fprintln(&frame, message)
+	}
+
+	io.WriteString(ui.output, frame.String())
+}
+
+// moveList is the side panel of moves, a numbered pair of columns per line, keeping the latest moves that fit in rows lines
+func (ui *TerminalUI) moveList(turnHistory []RecordedTurn, rows int) []string {
+	pairs := []string{}
+	for ndx := 0; ndx < len(turnHistory); ndx += NumPlayers {
+		pair := fmt.Sprintf("%3d.", ndx/NumPlayers+1)
+		for _, turn := range turnHistory[ndx:min(ndx+NumPlayers, len(turnHistory))] {
+			pair += fmt.Sprintf(" %s%2d", ui.disc(turn.PlayerValue, false), turn.Column+1)
+		}
+		pairs = append(pairs, pair)
+	}
+
+	pairs = pairs[max(0, len(pairs)-(rows-1)):]
+	return append([]string{"Moves"}, pairs...)
+}
+
+// moverValue is the player whose turn it is after the turns played so far
+func (ui *TerminalUI) moverValue(turnHistory []RecordedTurn) int {
+	return ui.playerValues[len(turnHistory)%NumPlayers]
+}
+
+func (ui *TerminalUI) colorOf(playerValue int) string {
+	if playerValue == ui.playerValues[1] {
+		return discColors[1]
+	}
+
+	return discColors[0]
+}
+
+func (ui *TerminalUI) disc(owner int, winning bool) string {
+	switch {
+	case owner == NoPlayer:
+		return ansiDim + "·" + ansiReset
+	case winning:
+		return ui.colorOf(owner) + ansiHighlight + "●" + ansiReset
+	default:
+		return ui.colorOf(owner) + "●" + ansiReset
+	}
+}
+
+// chooseColumn moves the cursor with the keys read until a column is dropped or a command key is pressed
+//
+// The left and right arrows, a and d, or h and l move the cursor, a digit jumps to that column,
+// and enter, space, s or the down arrow drop the piece. The command keys are ? for a hint, u to undo and q to resign.
+// chooseColumn returns the column dropped or the command key, and StatusResign when the keys run out.
+func (ui *TerminalUI) chooseColumn(gameBoard BoardView, keys *bufio.Reader, cursor int, message string) (int, byte) {
+	for {
+		ui.showBoard(gameBoard, cursor, message)
+		message = ""
+
+		key, err := keys.ReadByte()
+		if err != nil {
+			return StatusResign, 0
+		}
+
+		if key == '\x1b' {
+			key = readArrowKey(keys)
+		}
+
+		switch {
+		case key == 'a' || key == 'h' || key == 'D':
+			cursor = (cursor + gameBoard.GetWidth() - 1) % gameBoard.GetWidth()
+		case key == 'd' || key == 'l' || key == 'C':
+			cursor = (cursor + 1) % gameBoard.GetWidth()
+		case key >= '1' && key <= '9' && int(key-'1') < gameBoard.GetWidth():
+			cursor = int(key - '1')
+		case key == '\r' || key == '\n' || key == ' ' || key == 's' || key == 'B':
+			if gameBoard.AvailableRow(cursor) != StatusRowIsFull {
+				return cursor, 0
+			}
+			message = fmt.Sprintf("Column %d is full", cursor+1)
+		case key == '?' || key == 'u' || key == 'q':
+			return cursor, key
+		}
+	}
+}
+
+// readArrowKey reads the rest of an arrow key's escape sequence, returning its final letter A to D
+func readArrowKey(keys *bufio.Reader) byte {
+	if bracket, err := keys.ReadByte(); err != nil || bracket != '[' {
+		return 0
+	}
+
+	letter, err := keys.ReadByte()
+	if err != nil {
+		return 0
+	}
+
+	return letter
+}
+
+// visibleWidth counts the characters of s that take up space on the screen
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(ansiEscape.ReplaceAllString(s, ""))
+}
+
+// formatClock shows a duration as minutes and seconds to a tenth, like 1:05.3
+func formatClock(d time.Duration) string {
+	tenths := d.Round(100*time.Millisecond) / (100 * time.Millisecond)
+	return fmt.Sprintf("%d:%02d.%d", tenths/600, tenths/10%60, tenths%10)
+}
@@ -0,0 +1,180 @@
+package game
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTerminalUIDrawsColouredDiscsAndTheWinningLine(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("12233434474")
+	if err != nil {
+		t.Fatalf(`TestTerminalUIDrawsColouredDiscsAndTheWinningLine could not build the board: %v`, err)
+	}
+
+	var screen bytes.Buffer
+	NewTerminalUI(&screen, 0).showMove(gameBoard)
+
+	for _, expected := range []string{ansiClearScreen, discColors[0] + "●", discColors[1] + "●", "Moves", "  1. ", "  6. ", "Clock (used)"} {
+		if !strings.Contains(screen.String(), expected) {
+			t.Errorf(`TestTerminalUIDrawsColouredDiscsAndTheWinningLine expected %q in %q`, expected, screen.String())
+		}
+	}
+	if count := strings.Count(screen.String(), ansiHighlight); count != WinningLength {
+		t.Errorf(`TestTerminalUIDrawsColouredDiscsAndTheWinningLine expected %d highlighted discs but got %d`, WinningLength, count)
+	}
+}
+
+func TestTerminalUIDropsThePieceDownItsColumn(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("444")
+	if err != nil {
+		t.Fatalf(`TestTerminalUIDropsThePieceDownItsColumn could not build the board: %v`, err)
+	}
+
+	var screen bytes.Buffer
+	NewTerminalUI(&screen, time.Nanosecond).showMove(gameBoard)
+
+	// a frame for each row above the one the piece lands in, then the board
+	frames := strings.Split(screen.String(), ansiClearScreen)[1:]
+	if landingRow := BoardHeight - 3; len(frames) != landingRow+1 {
+		t.Fatalf(`TestTerminalUIDropsThePieceDownItsColumn expected %d frames but got %d`, landingRow+1, len(frames))
+	}
+	// every frame shows the two pieces already played and the falling piece, as well as the discs
+	// beside the header, the clock and the moves
+	for ndx, frame := range frames {
+		if red, yellow := strings.Count(frame, discColors[0]+"●"), strings.Count(frame, discColors[1]+"●"); red != 6 || yellow != 4 {
+			t.Errorf(`TestTerminalUIDropsThePieceDownItsColumn expected frame %d to show 6 red and 4 yellow discs but got %d and %d`, ndx, red, yellow)
+		}
+	}
+}
+
+func TestTerminalUIMoveListKeepsTheLatestMoves(t *testing.T) {
+	turnHistory := []RecordedTurn{}
+	for ndx := range 9 {
+		turnHistory = append(turnHistory, RecordedTurn{PlayerValue: ndx%NumPlayers + 1, Column: ndx % BoardWidth})
+	}
+
+	moveList := NewTerminalUI(&bytes.Buffer{}, 0).moveList(turnHistory, 3)
+
+	if len(moveList) != 3 || moveList[0] != "Moves" || !strings.HasPrefix(moveList[1], "  4. ") || !strings.HasPrefix(moveList[2], "  5. ") {
+		t.Errorf(`TestTerminalUIMoveListKeepsTheLatestMoves expected the header and moves 4 and 5 but got %q`, moveList)
+	}
+	if moveList[2] != "  5. "+discColors[0]+"●"+ansiReset+" 2" {
+		t.Errorf(`TestTerminalUIMoveListKeepsTheLatestMoves expected the last move alone on its line but got %q`, moveList[2])
+	}
+}
+
+func TestTerminalSupportsColor(t *testing.T) {
+	if TerminalSupportsColor(&bytes.Buffer{}) {
+		t.Errorf(`TestTerminalSupportsColor expected no colour when writing to a buffer`)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if TerminalSupportsColor(os.Stdout) {
+		t.Errorf(`TestTerminalSupportsColor expected NO_COLOR to turn the colour off`)
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	for duration, expected := range map[time.Duration]string{0: "0:00.0", 4840 * time.Millisecond: "0:04.8", 65300 * time.Millisecond: "1:05.3"} {
+		if clock := formatClock(duration); clock != expected {
+			t.Errorf(`TestFormatClock expected %v to show as %v but got %v`, duration, expected, clock)
+		}
+	}
+}
+
+func TestHumanChoosesWithTheCursor(t *testing.T) {
+	tests := map[string]int{
+		"\x1b[C\x1b[C\r": 5,
+		"aaaa ":          6,
+		"7\n":            6,
+		"u1s":            0,
+		"":               StatusResign,
+		"dq":             StatusResign,
+	}
+
+	for keys, expected := range tests {
+		player := NewPlayerStrategyHumanWithTerminalUI(1, strings.NewReader(keys), NewTerminalUI(&bytes.Buffer{}, 0), "firstavailable")
+
+		if chosenColumn := player.PlayerChoosesAMove(NewBoardView(NewGameBoard(), 1, 2)); chosenColumn != expected {
+			t.Errorf(`TestHumanChoosesWithTheCursor expected keys %q to choose %d but got %d`, keys, expected, chosenColumn)
+		}
+	}
+}
+
+func TestHumanCursorCannotDropInAFullColumn(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("444444")
+	if err != nil {
+		t.Fatalf(`TestHumanCursorCannotDropInAFullColumn could not build the board: %v`, err)
+	}
+
+	var screen bytes.Buffer
+	player := NewPlayerStrategyHumanWithTerminalUI(1, strings.NewReader("4\r?3\r"), NewTerminalUI(&screen, 0), "firstavailable")
+
+	if chosenColumn := player.PlayerChoosesAMove(NewBoardView(gameBoard, 1, 2)); chosenColumn != 2 {
+		t.Errorf(`TestHumanCursorCannotDropInAFullColumn expected column 2 but got %d`, chosenColumn)
+	}
+	for _, expected := range []string{"Column 4 is full", "Hint: First Available Move Strategy plays column 3"} {
+		if !strings.Contains(screen.String(), expected) {
+			t.Errorf(`TestHumanCursorCannotDropInAFullColumn expected %q on the screen`, expected)
+		}
+	}
+}
+
+func TestHumanReadsKeysAndLinesFromOneInput(t *testing.T) {
+	keyModes := 0
+	player := &PlayerStrategyHuman{
+		playerValue: 1,
+		input:       bufio.NewReader(strings.NewReader("\r5\n")),
+		output:      io.Discard,
+		terminalUI:  NewTerminalUI(&bytes.Buffer{}, 0),
+		keyMode: func() (func(), error) {
+			// the terminal stops reading single keys after the first move
+			keyModes++
+			if keyModes > 1 {
+				return nil, errors.New("no terminal")
+			}
+			return func() {}, nil
+		},
+	}
+	gameBoard := NewBoardView(NewGameBoard(), 1, 2)
+
+	if chosenColumn := player.PlayerChoosesAMove(gameBoard); chosenColumn != 3 {
+		t.Errorf(`TestHumanReadsKeysAndLinesFromOneInput expected the key to drop in column 3 but got %d`, chosenColumn)
+	}
+	if chosenColumn := player.PlayerChoosesAMove(gameBoard); chosenColumn != 4 {
+		t.Errorf(`TestHumanReadsKeysAndLinesFromOneInput expected the line after the key to choose column 4 but got %d`, chosenColumn)
+	}
+}
+
+func TestGameOnTheTerminalUI(t *testing.T) {
+	var printed, screen bytes.Buffer
+	config := NewDefaultGameConfig()
+	config.Player1 = "firstavailable"
+	config.Player2 = "firstavailable"
+	config.TotalClock = time.Minute
+	config.Output = &printed
+	config.TerminalUI = NewTerminalUI(&screen, 0)
+
+	result := PlayConnect4(config)
+
+	if result.EndReason != GameEndConnect {
+		t.Fatalf(`TestGameOnTheTerminalUI expected a connection but got %v`, result.Message())
+	}
+	if strings.Contains(printed.String(), "Turn") {
+		t.Errorf(`TestGameOnTheTerminalUI expected no printed boards but got %v`, printed.String())
+	}
+	for _, expected := range []string{"First Available Move Strategy", "Clock (left)", ansiHighlight} {
+		if !strings.Contains(screen.String(), expected) {
+			t.Errorf(`TestGameOnTheTerminalUI expected %q on the screen`, expected)
+		}
+	}
+	if frames := strings.Count(screen.String(), ansiClearScreen); frames != len(result.Turns)+1 {
+		t.Errorf(`TestGameOnTheTerminalUI expected a frame for the start and each of the %d moves but got %d`, len(result.Turns), frames)
+	}
+}
@@ -0,0 +1,177 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Violation is one reason a position could not have come from a real game
+type Violation struct {
+	Cells  []Cell // the spaces at fault
+	Reason string
+}
+
+func (violation Violation) Error() string {
+	coordinates := make([]string, len(violation.Cells))
+	for ndx, cell := range violation.Cells {
+		coordinates[ndx] = fmt.Sprintf("[%d][%d]", cell.Column, cell.Row)
+	}
+
+	if len(coordinates) == 0 {
+		return violation.Reason
+	}
+
+	return strings.Join(coordinates, " ") + " " + violation.Reason
+}
+
+// PositionError lists every violation found on a board
+type PositionError struct {
+	Violations []Violation
+}
+
+func (positionError *PositionError) Error() string {
+	messages := make([]string, len(positionError.Violations))
+	for ndx, violation := range positionError.Violations {
+		messages[ndx] = violation.Error()
+	}
+
+	return "invalid position: " + strings.Join(messages, "; ")
+}
+
+// ErrInvalidPosition matches every *PositionError with errors.Is
+var ErrInvalidPosition = errors.New("invalid position")
+
+func (positionError *PositionError) Is(target error) bool {
+	return target == ErrInvalidPosition
+}
+
+// Validate reports every way the board could not have come from a real game as a *PositionError, nil for a valid board
+//
+// It finds pieces floating above an empty space, pieces of a third player, piece counts that differ by more than one,
+// or in the wrong player's favour when the turn history says who moved first, and boards where both players have won.
+// Coordinates are [x][y] board coordinates, row 0 is the top of the board.
+func (gameBoard GameBoard) Validate() error {
+	return validatePosition(&gameBoard, firstPlayerOf(&gameBoard))
+}
+
+// firstPlayerOf is the player who moved first according to the turn history, NoPlayer when there is no history
+func firstPlayerOf(gameBoard GameBoardActions) int {
+	turnHistory := gameBoard.GetTurnHistory()
+	if len(turnHistory) == 0 {
+		return NoPlayer
+	}
+
+	return turnHistory[0].PlayerValue
+}
+
+// validatePosition is Validate for any board, firstPlayer is NoPlayer when it is not known who moved first
+func validatePosition(gameBoard GameBoardActions, firstPlayer int) error {
+	violations := []Violation{}
+	pieces := map[int][]Cell{}
+
+	for x := range gameBoard.GetWidth() {
+		for y := range gameBoard.GetHeight() {
+			owner := gameBoard.GetSpaceOwnership(x, y)
+			if owner == NoPlayer {
+				continue
+			}
+			pieces[owner] = append(pieces[owner], Cell{Column: x, Row: y})
+
+			if y+1 < gameBoard.GetHeight() && gameBoard.GetSpaceOwnership(x, y+1) == NoPlayer {
+				violations = append(violations, Violation{
+					Cells:  []Cell{{Column: x, Row: y}},
+					Reason: fmt.Sprintf("is floating above the empty space [%d][%d]", x, y+1),
+				})
+			}
+		}
+	}
+
+	if firstPlayer != NoPlayer && pieces[firstPlayer] == nil {
+		pieces[firstPlayer] = []Cell{}
+	}
+
+	players := playersInPlay(pieces, firstPlayer)
+	for owner, cells := range pieces {
+		if !slices.Contains(players, owner) {
+			violations = append(violations, Violation{
+				Cells:  cells,
+				Reason: fmt.Sprintf("belong to %d, which is not one of the players in play %v", owner, players),
+			})
+		}
+	}
+
+	if len(players) > 0 {
+		// a player with no pieces yet counts as none, the board may only hold the first move
+		first, second := players[0], NoPlayer
+		secondName := "the other player"
+		if len(players) == NumPlayers {
+			second = players[1]
+			secondName = fmt.Sprintf("player %d", second)
+		}
+		firstCount, secondCount := len(pieces[first]), len(pieces[second])
+
+		if firstPlayer != NoPlayer && firstCount != secondCount && firstCount != secondCount+1 {
+			violations = append(violations, Violation{Reason: fmt.Sprintf("player %d has %d pieces and %s has %d, player %d moves first so should have the same number or one more", first, firstCount, secondName, secondCount, first)})
+		} else if firstPlayer == NoPlayer && max(firstCount, secondCount)-min(firstCount, secondCount) > 1 {
+			violations = append(violations, Violation{Reason: fmt.Sprintf("player %d has %d pieces and %s has %d, the players take turns so the counts differ by at most one", first, firstCount, secondName, secondCount)})
+		}
+	}
+
+	if len(players) == NumPlayers {
+		first, second := players[0], players[1]
+		firstConnection := findConnection(gameBoard, first)
+		secondConnection := findConnection(gameBoard, second)
+		if firstConnection != nil && secondConnection != nil {
+			violations = append(violations, Violation{
+				Cells:  append(firstConnection, secondConnection...),
+				Reason: fmt.Sprintf("are connections of both player %d and player %d, the game ends when the first one is made", first, second),
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &PositionError{Violations: violations}
+}
+
+// playersInPlay picks the two players of a position, the first player first when it is known,
+// otherwise the players with the most pieces, the lower value first when the counts are equal
+func playersInPlay(pieces map[int][]Cell, firstPlayer int) []int {
+	owners := []int{}
+	for owner := range pieces {
+		owners = append(owners, owner)
+	}
+	slices.SortFunc(owners, func(a, b int) int {
+		if a == firstPlayer || b == firstPlayer {
+			if a == firstPlayer {
+				return -1
+			}
+			return 1
+		}
+		if len(pieces[a]) != len(pieces[b]) {
+			return len(pieces[b]) - len(pieces[a])
+		}
+		return a - b
+	})
+
+	if len(owners) > NumPlayers {
+		owners = owners[:NumPlayers]
+	}
+
+	return owners
+}
+
+// findConnection is the first winning line of the player found on the board, nil when there is none
+func findConnection(gameBoard GameBoardActions, playerValue int) []Cell {
+	for _, line := range gameBoard.WinningLines() {
+		if line.PlayerValue == playerValue {
+			return line.Cells
+		}
+	}
+
+	return nil
+}
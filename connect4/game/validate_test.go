@@ -0,0 +1,129 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func violationsOf(t *testing.T, err error) []Violation {
+	t.Helper()
+
+	var positionError *PositionError
+	if !errors.As(err, &positionError) {
+		t.Fatalf(`expected a PositionError but got %v`, err)
+	}
+	if !errors.Is(err, ErrInvalidPosition) {
+		t.Errorf(`expected %v to match ErrInvalidPosition`, err)
+	}
+
+	return positionError.Violations
+}
+
+func TestValidateAcceptsPlayedGame(t *testing.T) {
+	gameBoard, _ := NewGameBoardFromMoves("4455433")
+
+	if err := gameBoard.Validate(); err != nil {
+		t.Errorf(`TestValidateAcceptsPlayedGame expected a valid position but got %v`, err)
+	}
+}
+
+func TestValidateReportsEveryViolation(t *testing.T) {
+	gameBoard := newUncheckedInProgressGameBoard([][]int{
+		{-1, 7, -1, -1},
+		{-1, -1, -1, -1},
+		{1, -1, -1, -1},
+		{1, -1, 2, -1},
+		{1, -1, 2, -1},
+		{1, 1, 2, 1},
+	}, WinningLength)
+
+	violations := violationsOf(t, gameBoard.Validate())
+
+	expected := []Violation{
+		{Cells: []Cell{{Column: 1, Row: 0}}, Reason: "is floating above the empty space [1][1]"},
+		{Cells: []Cell{{Column: 1, Row: 0}}, Reason: "belong to 7, which is not one of the players in play [1 2]"},
+		{Reason: "player 1 has 6 pieces and player 2 has 3, the players take turns so the counts differ by at most one"},
+	}
+	if !reflect.DeepEqual(violations, expected) {
+		t.Errorf(`TestValidateReportsEveryViolation expected %v but got %v`, expected, violations)
+	}
+}
+
+func TestValidateReportsBothPlayersConnected(t *testing.T) {
+	gameBoard := newUncheckedInProgressGameBoard([][]int{
+		{1, 2},
+		{1, 2},
+		{1, 2},
+		{1, 2},
+	}, WinningLength)
+
+	violations := violationsOf(t, gameBoard.Validate())
+
+	if len(violations) != 1 || len(violations[0].Cells) != 2*WinningLength || !strings.Contains(violations[0].Error(), "[0][0] [0][1] [0][2] [0][3] [1][0]") {
+		t.Errorf(`TestValidateReportsBothPlayersConnected expected both connections but got %v`, violations)
+	}
+}
+
+func TestValidateKnowsWhoMovedFirst(t *testing.T) {
+	gameBoard := NewGameBoard()
+	gameBoard.PlayPiece(2, 0)
+	gameBoard.PlayPiece(1, 1)
+	gameBoard.PlayPiece(1, 2)
+
+	violations := violationsOf(t, gameBoard.Validate())
+
+	if len(violations) != 1 || !strings.Contains(violations[0].Reason, "player 2 moves first") {
+		t.Errorf(`TestValidateKnowsWhoMovedFirst expected player 2 to be one piece short but got %v`, violations)
+	}
+}
+
+func TestValidateCountsAnOpponentWithNoPieces(t *testing.T) {
+	gameBoard := NewGameBoard()
+	gameBoard.PlayPiece(1, 0)
+	gameBoard.PlayPiece(1, 1)
+	gameBoard.PlayPiece(1, 2)
+
+	violations := violationsOf(t, gameBoard.Validate())
+
+	expected := "player 1 has 3 pieces and the other player has 0, player 1 moves first so should have the same number or one more"
+	if len(violations) != 1 || violations[0].Reason != expected {
+		t.Errorf(`TestValidateCountsAnOpponentWithNoPieces expected %q but got %v`, expected, violations)
+	}
+
+	gameBoard = NewGameBoard()
+	gameBoard.PlayPiece(1, 0)
+	if err := gameBoard.Validate(); err != nil {
+		t.Errorf(`TestValidateCountsAnOpponentWithNoPieces expected the first move alone to be valid but got %v`, err)
+	}
+}
+
+func TestNewInProgressGameBoardPanicsOnInvalidPosition(t *testing.T) {
+	defer func() {
+		if recovered := recover(); recovered == nil {
+			t.Errorf(`TestNewInProgressGameBoardPanicsOnInvalidPosition expected a floating piece to panic`)
+		}
+	}()
+
+	NewInProgressGameBoard([BoardHeight][BoardWidth]int{
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 1, -1, -1, -1},
+		{-1, -1, -1, -1, -1, -1, -1},
+		{-1, -1, -1, 2, -1, -1, -1},
+	})
+}
+
+func TestPlayConnect4InDebugMode(t *testing.T) {
+	config := NewDefaultGameConfig()
+	config.Debug = true
+	config.Output = nil
+
+	result := PlayConnect4(config)
+
+	if result.EndReason == GameEndInvalidPosition {
+		t.Errorf(`TestPlayConnect4InDebugMode expected every position to be valid but got %v`, result.Err)
+	}
+}
@@ -0,0 +1,74 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WinningLine is a run of at least winningLength pieces of one player
+// A run longer than winningLength is one line with every one of its cells
+type WinningLine struct {
+	PlayerValue int
+	Direction   Direction
+	Cells       []Cell
+}
+
+// String lists the cells of the line with their [x][y] coordinates
+func (line WinningLine) String() string {
+	coordinates := make([]string, len(line.Cells))
+	for ndx, cell := range line.Cells {
+		coordinates[ndx] = fmt.Sprintf("[%d][%d]", cell.Column, cell.Row)
+	}
+
+	return fmt.Sprintf("Player %d %s %s", line.PlayerValue, line.Direction, strings.Join(coordinates, " "))
+}
+
+// findWinningLines lists every winning line on the board, lane by lane, see boardLanes
+func findWinningLines(gameBoard GameBoardActions) []WinningLine {
+	lines := []WinningLine{}
+	for _, lane := range boardLanes(gameBoard.GetWidth(), gameBoard.GetHeight(), gameBoard.GetWinningLength()) {
+		lines = append(lines, laneRuns(gameBoard, lane)...)
+	}
+
+	return lines
+}
+
+// connectsThroughLastTurn reports whether the last piece played is part of a connection,
+// counting along the four lines through it
+func connectsThroughLastTurn(gameBoard boardReader, turnHistory []RecordedTurn) bool {
+	if len(turnHistory) == 0 {
+		return false
+	}
+
+	last := turnHistory[len(turnHistory)-1]
+	for _, step := range directionSteps {
+		piecesInARow := 1
+
+		for _, sign := range [2]int{-1, 1} {
+			column, row := last.Column+sign*step.column, last.Row+sign*step.row
+			for column >= 0 && column < gameBoard.GetWidth() && row >= 0 && row < gameBoard.GetHeight() &&
+				gameBoard.GetSpaceOwnership(column, row) == last.PlayerValue {
+				piecesInARow++
+				column, row = column+sign*step.column, row+sign*step.row
+			}
+		}
+
+		if piecesInARow >= gameBoard.GetWinningLength() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// winningCellSet is every cell of the lines, for highlighting them
+func winningCellSet(lines []WinningLine) map[Cell]bool {
+	cells := map[Cell]bool{}
+	for _, line := range lines {
+		for _, cell := range line.Cells {
+			cells[cell] = true
+		}
+	}
+
+	return cells
+}
@@ -0,0 +1,96 @@
+package game
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWinningLinesOfADiagonal(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("12233434474")
+	if err != nil {
+		t.Fatalf(`TestWinningLinesOfADiagonal could not build the board: %v`, err)
+	}
+
+	expected := []WinningLine{{
+		PlayerValue: 1,
+		Direction:   DirectionDiagonalUp,
+		Cells:       []Cell{{Column: 0, Row: 5}, {Column: 1, Row: 4}, {Column: 2, Row: 3}, {Column: 3, Row: 2}},
+	}}
+	if lines := gameBoard.WinningLines(); !reflect.DeepEqual(lines, expected) {
+		t.Errorf(`TestWinningLinesOfADiagonal expected %v but got %v`, expected, lines)
+	}
+}
+
+func TestWinningLineString(t *testing.T) {
+	line := WinningLine{PlayerValue: 2, Direction: DirectionVertical, Cells: []Cell{{Column: 3, Row: 2}, {Column: 3, Row: 3}}}
+
+	if line.String() != "Player 2 vertical [3][2] [3][3]" {
+		t.Errorf(`TestWinningLineString got %v`, line.String())
+	}
+}
+
+func TestWinningLinesMadeByOneMove(t *testing.T) {
+	gameBoard, err := NewGameBoardFromRows("......./......./...1.../...1.../1111.../2221222", WinningLength)
+	if err != nil {
+		t.Fatalf(`TestWinningLinesMadeByOneMove could not build the board: %v`, err)
+	}
+
+	expected := []WinningLine{
+		{PlayerValue: 1, Direction: DirectionHorizontal, Cells: []Cell{{Column: 0, Row: 4}, {Column: 1, Row: 4}, {Column: 2, Row: 4}, {Column: 3, Row: 4}}},
+		{PlayerValue: 1, Direction: DirectionVertical, Cells: []Cell{{Column: 3, Row: 2}, {Column: 3, Row: 3}, {Column: 3, Row: 4}, {Column: 3, Row: 5}}},
+	}
+	if lines := gameBoard.WinningLines(); !reflect.DeepEqual(lines, expected) {
+		t.Errorf(`TestWinningLinesMadeByOneMove expected %v but got %v`, expected, lines)
+	}
+}
+
+func TestWinningLinesKeepLongRunsWhole(t *testing.T) {
+	gameBoard, err := NewGameBoardFromRows("......./......./......./......./22.22../11111..", WinningLength)
+	if err != nil {
+		t.Fatalf(`TestWinningLinesKeepLongRunsWhole could not build the board: %v`, err)
+	}
+
+	lines := gameBoard.WinningLines()
+	if len(lines) != 1 || len(lines[0].Cells) != 5 || lines[0].Direction != DirectionHorizontal {
+		t.Errorf(`TestWinningLinesKeepLongRunsWhole expected one horizontal line of 5 cells but got %v`, lines)
+	}
+}
+
+func TestWinningLinesOnEveryBoardImplementation(t *testing.T) {
+	moves := "12233434474"
+	gameBoard, err := NewGameBoardFromMoves(moves)
+	if err != nil {
+		t.Fatalf(`TestWinningLinesOnEveryBoardImplementation could not build the board: %v`, err)
+	}
+
+	bitBoard := newStandardBitBoard(t)
+	for ndx, move := range moves {
+		bitBoard.PlayPiece(ndx%NumPlayers+1, int(move-'1'))
+	}
+
+	if !reflect.DeepEqual(bitBoard.WinningLines(), gameBoard.WinningLines()) {
+		t.Errorf(`TestWinningLinesOnEveryBoardImplementation expected %v but got %v`, gameBoard.WinningLines(), bitBoard.WinningLines())
+	}
+	if lines := NewGameBoard().WinningLines(); len(lines) != 0 {
+		t.Errorf(`TestWinningLinesOnEveryBoardImplementation expected no lines on an empty board but got %v`, lines)
+	}
+}
+
+func TestPrintGameBoardHighlightsWinningLines(t *testing.T) {
+	gameBoard, err := NewGameBoardFromMoves("12233434474")
+	if err != nil {
+		t.Fatalf(`TestPrintGameBoardHighlightsWinningLines could not build the board: %v`, err)
+	}
+
+	var printed bytes.Buffer
+	ASCIIRenderer{}.Render(&printed, gameBoard.Snapshot())
+
+	if count := strings.Count(printed.String(), "1* "); count != WinningLength {
+		t.Errorf(`TestPrintGameBoardHighlightsWinningLines expected %d highlighted pieces but got %d in %v`, WinningLength, count, printed.String())
+	}
+	if strings.Contains(printed.String(), "2*") {
+		t.Errorf(`TestPrintGameBoardHighlightsWinningLines expected only player 1's pieces to be highlighted in %v`, printed.String())
+	}
+}
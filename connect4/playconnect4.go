@@ -2,25 +2,258 @@ package main
 
 import (
 	"connect4/game"
+	"connect4/ratings"
+	"connect4/tournament"
 	"flag"
 	"fmt"
+	"os"
+	"strings"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "solve":
+			solve(os.Args[2:])
+			return
+		case "tournament":
+			playTournament(os.Args[2:])
+			return
+		case "ratings":
+			printRatings(os.Args[2:])
+			return
+		case "replay":
+			replay(os.Args[2:])
+			return
+		}
+	}
+
+	play(os.Args[1:])
+}
+
+func play(args []string) {
 	fmt.Println("Let's Play Connect 4")
 	playerRegistryHelp := game.GetHelpMessageOfPlayerRegistry()
 
-	argPlayer1 := flag.String("player1", "random", playerRegistryHelp)
-	argPlayer2 := flag.String("player2", "random", playerRegistryHelp)
-	argPrintBoardCadence := flag.Int("printboard", 5, "Print the board to the display every n turns")
-	flag.Parse()
+	flags := flag.NewFlagSet("playconnect4", flag.ExitOnError)
+	argPlayer1 := flags.String("player1", "random", playerRegistryHelp)
+	argPlayer2 := flags.String("player2", "random", playerRegistryHelp)
+	argPrintBoardCadence := flags.Int("printboard", 5, "Print the board to the display every n turns")
+	argWidth := flags.Int("width", game.BoardWidth, "The number of columns on the board")
+	argHeight := flags.Int("height", game.BoardHeight, "The number of rows on the board")
+	argWinningLength := flags.Int("connect", game.WinningLength, "The number of pieces in a row needed to win")
+	argBoard := flags.String("board", game.BoardImplementationArray, "The board implementation: "+game.BoardImplementationArray+" or "+game.BoardImplementationBitBoard)
+	argMoveTime := flags.Duration("movetime", 0, "The longest a player may think about one move, for example 500ms (default no limit)")
+	argClock := flags.Duration("clock", 0, "Each player's time for the whole game, for example 1m (default no clock)")
+	argIncrement := flags.Duration("increment", 0, "Time added to a player's clock after each of their moves")
+	argTimeoutPolicy := flags.String("ontimeout", game.TimeoutPolicyFirstAvailable, "What happens when a player runs out of time: "+game.TimeoutPolicyForfeit+", "+game.TimeoutPolicyRandomMove+" or "+game.TimeoutPolicyFirstAvailable)
+	argIllegalMovePolicy := flags.String("onillegal", game.IllegalMovePolicySubstitute, "What happens when a player chooses a column that cannot be played: "+game.IllegalMovePolicyForfeit+", "+game.IllegalMovePolicyRetry+" or "+game.IllegalMovePolicySubstitute)
+	argIllegalMoveRetries := flags.Int("retries", 2, "How many more times the retry policy asks a player to choose")
+	argSeed := flags.Int64("seed", 0, "Seeds every random choice so that the game can be replayed (default a random seed)")
+	argRecord := flags.String("record", "", "Save the game record to this file")
+	argDebug := flags.Bool("debug", false, "Check the board is a valid position after every move")
+	argHint := flags.String("hint", game.DefaultHumanHintStrategy, "The Player Strategy key a human player asks for a hint")
+	argRender := flags.String("render", game.RendererASCII, "How the board is drawn: "+strings.Join(game.RendererNames, ", "))
+	argTUI := flags.Bool("tui", false, "Draw the game full screen with coloured discs, terminals without colour print the board instead")
+	flags.Parse(args)
 
 	config := game.NewDefaultGameConfig()
 	config.Player1 = *argPlayer1
 	config.Player2 = *argPlayer2
 	config.ModuloToPrintGameBoard = *argPrintBoardCadence
+	config.BoardWidth = *argWidth
+	config.BoardHeight = *argHeight
+	config.WinningLength = *argWinningLength
+	config.BoardImplementation = *argBoard
+	config.MoveTimeBudget = *argMoveTime
+	config.TotalClock = *argClock
+	config.ClockIncrement = *argIncrement
+	config.TimeoutPolicy = *argTimeoutPolicy
+	config.IllegalMovePolicy = *argIllegalMovePolicy
+	config.IllegalMoveRetries = *argIllegalMoveRetries
+	config.Seed = *argSeed
+	config.Debug = *argDebug
+	config.HumanHint = *argHint
+
+	renderer, err := game.NewRenderer(*argRender)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	config.Renderer = renderer
+
+	if *argTUI {
+		if game.TerminalSupportsColor(os.Stdout) {
+			config.TerminalUI = game.NewTerminalUI(os.Stdout, game.TerminalUIFrameDelay)
+		} else {
+			fmt.Println("This terminal has no colour, printing the board instead")
+		}
+	}
+
+	if err := config.ValidateBoardSize(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := config.ValidateTimeControl(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := config.ValidateIllegalMovePolicy(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if err := config.ValidateHumanHint(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	result := game.PlayConnect4(config)
+
+	fmt.Println(result.Message())
+	for _, line := range result.WinningLines {
+		fmt.Println(`Winning line: `, line)
+	}
+	fmt.Printf("Replay this game with --seed %d\n", result.Seed)
+
+	if *argRecord != "" {
+		if err := os.WriteFile(*argRecord, []byte(game.NewGameRecord(result).String()), 0644); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+}
+
+func solve(args []string) {
+	flags := flag.NewFlagSet("solve", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: playconnect4 solve [moves]")
+		fmt.Fprintln(flags.Output(), "  moves lists the columns played so far numbered 1 to 7, for example 4453")
+	}
+	flags.Parse(args)
+
+	moves := flags.Arg(0)
+	solver := game.NewSolver()
+
+	columnScores, err := solver.ScoreColumnsForMoves(moves)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Position %q with player %d to move\n", moves, len(moves)%game.NumPlayers+1)
+	for _, columnScore := range columnScores {
+		if !columnScore.Playable {
+			fmt.Printf("Column %d: full\n", columnScore.Column+1)
+			continue
+		}
+
+		result := columnScore.Result
+		fmt.Printf("Column %d: %s in %d moves (score %d)\n", columnScore.Column+1, result.Outcome, result.MovesToEnd, result.Score)
+	}
+}
+
+func playTournament(args []string) {
+	flags := flag.NewFlagSet("tournament", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: playconnect4 tournament [flags] [strategies]")
+		fmt.Fprintln(flags.Output(), "  strategies lists the players, every registered strategy when empty. "+game.GetHelpMessageOfPlayerRegistry())
+		flags.PrintDefaults()
+	}
+	argRounds := flags.Int("rounds", 1, "Each round plays every pairing twice, once with each player moving first")
+	argWidth := flags.Int("width", game.BoardWidth, "The number of columns on the board")
+	argHeight := flags.Int("height", game.BoardHeight, "The number of rows on the board")
+	argWinningLength := flags.Int("connect", game.WinningLength, "The number of pieces in a row needed to win")
+	argMoveTime := flags.Duration("movetime", 0, "The longest a player may think about one move, for example 500ms (default no limit)")
+	argWorkers := flags.Int("workers", 0, "How many games are played at once (default one per CPU)")
+	argSeed := flags.Int64("seed", 0, "Seeds every game so that the tournament can be replayed (default a random seed)")
+	argArchive := flags.String("archive", "", "Save the game records of every game to this file")
+	argRatings := flags.String("ratings", "", "Add the games to the ratings ladder kept in this file, for example "+ratings.DefaultLadderFile)
+	flags.Parse(args)
+
+	options := tournament.NewDefaultOptions()
+	options.Strategies = flags.Args()
+	options.Rounds = *argRounds
+	options.Config.BoardWidth = *argWidth
+	options.Config.BoardHeight = *argHeight
+	options.Config.WinningLength = *argWinningLength
+	options.Config.MoveTimeBudget = *argMoveTime
+	options.Workers = *argWorkers
+	options.Seed = *argSeed
+
+	if err := options.Config.ValidateBoardSize(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	results, err := tournament.Run(options)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	fmt.Println()
+	results.PrintCrosstable(os.Stdout)
+	fmt.Println()
+	results.PrintLeaderboard(os.Stdout)
+	fmt.Printf("\nReplay this tournament with --seed %d\n", results.Seed)
+
+	if *argArchive != "" {
+		if err := archiveGames(*argArchive, results.Games); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	if *argRatings != "" {
+		ladder, err := ratings.Load(*argRatings)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		ladder.RecordGames(results.Games)
+		if err := ladder.Save(*argRatings); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+
+		fmt.Println()
+		ladder.PrintLadder(os.Stdout)
+	}
+}
+
+func archiveGames(path string, results []game.GameResult) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	records := make([]game.GameRecord, len(results))
+	for ndx, result := range results {
+		records[ndx] = game.NewGameRecord(result)
+	}
+
+	if err := game.WriteGameRecords(file, records); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func printRatings(args []string) {
+	flags := flag.NewFlagSet("ratings", flag.ExitOnError)
+	argFile := flags.String("file", ratings.DefaultLadderFile, "The file the ratings ladder is kept in")
+	flags.Parse(args)
 
-	_, message := game.PlayConnect4(config)
+	ladder, err := ratings.Load(*argFile)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 
-	fmt.Println(message)
+	ladder.PrintLadder(os.Stdout)
 }
@@ -0,0 +1,126 @@
+package ratings
+
+import (
+	"math"
+)
+
+// glicko2Scale converts between the displayed Glicko-2 rating and the scale the algorithm works on
+const glicko2Scale float64 = 173.7178
+
+// glicko2Tolerance is how closely the new volatility is found
+const glicko2Tolerance float64 = 0.000001
+
+const DefaultGlicko2Rating float64 = 1500
+const DefaultGlicko2Deviation float64 = 350
+const DefaultGlicko2Volatility float64 = 0.06
+
+// DefaultGlicko2Tau constrains how quickly the volatility can change
+const DefaultGlicko2Tau float64 = 0.5
+
+// Glicko2 is a rating with a deviation describing how sure we are of it and a volatility describing how erratic it is
+type Glicko2 struct {
+	Rating     float64 `json:"rating"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+}
+
+func NewDefaultGlicko2() Glicko2 {
+	return Glicko2{
+		Rating:     DefaultGlicko2Rating,
+		Deviation:  DefaultGlicko2Deviation,
+		Volatility: DefaultGlicko2Volatility,
+	}
+}
+
+// glicko2Game is one game of a rating period, score is 1 for a win, 0.5 for a draw and 0 for a loss
+type glicko2Game struct {
+	opponent Glicko2
+	score    float64
+}
+
+func glicko2G(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+func glicko2E(mu float64, opponentMu float64, opponentPhi float64) float64 {
+	return 1 / (1 + math.Exp(-glicko2G(opponentPhi)*(mu-opponentMu)))
+}
+
+// update rates the games of one rating period, following Glickman's "Example of the Glicko-2 system"
+// A rating without games only grows less certain.
+func (r Glicko2) update(games []glicko2Game, tau float64) Glicko2 {
+	mu := (r.Rating - DefaultGlicko2Rating) / glicko2Scale
+	phi := r.Deviation / glicko2Scale
+	sigma := r.Volatility
+
+	if len(games) == 0 {
+		r.Deviation = math.Sqrt(phi*phi+sigma*sigma) * glicko2Scale
+		return r
+	}
+
+	inverseV := 0.0
+	improvement := 0.0
+	for _, game := range games {
+		opponentMu := (game.opponent.Rating - DefaultGlicko2Rating) / glicko2Scale
+		opponentPhi := game.opponent.Deviation / glicko2Scale
+		g := glicko2G(opponentPhi)
+		e := glicko2E(mu, opponentMu, opponentPhi)
+
+		inverseV += g * g * e * (1 - e)
+		improvement += g * (game.score - e)
+	}
+	v := 1 / inverseV
+	delta := v * improvement
+
+	newSigma := glicko2Volatility(delta, phi, v, sigma, tau)
+
+	phiStar := math.Sqrt(phi*phi + newSigma*newSigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*improvement
+
+	return Glicko2{
+		Rating:     newMu*glicko2Scale + DefaultGlicko2Rating,
+		Deviation:  newPhi * glicko2Scale,
+		Volatility: newSigma,
+	}
+}
+
+// glicko2Volatility finds the new volatility with the Illinois algorithm
+func glicko2Volatility(delta float64, phi float64, v float64, sigma float64, tau float64) float64 {
+	lnSigmaSquared := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-lnSigmaSquared)/(tau*tau)
+	}
+
+	// the root lies between boundA and boundB
+	boundA := lnSigmaSquared
+	var boundB float64
+	if delta*delta > phi*phi+v {
+		boundB = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(lnSigmaSquared-k*tau) < 0 {
+			k++
+		}
+		boundB = lnSigmaSquared - k*tau
+	}
+
+	fA := f(boundA)
+	fB := f(boundB)
+	for math.Abs(boundB-boundA) > glicko2Tolerance {
+		boundC := boundA + (boundA-boundB)*fA/(fB-fA)
+		fC := f(boundC)
+		if fC*fB <= 0 {
+			boundA = boundB
+			fA = fB
+		} else {
+			fA /= 2
+		}
+		boundB = boundC
+		fB = fC
+	}
+
+	return math.Exp(boundA / 2)
+}
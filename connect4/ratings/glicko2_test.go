@@ -0,0 +1,31 @@
+package ratings
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGlicko2UpdateMatchesGlickmansExample(t *testing.T) {
+	player := Glicko2{Rating: 1500, Deviation: 200, Volatility: 0.06}
+	games := []glicko2Game{
+		{opponent: Glicko2{Rating: 1400, Deviation: 30}, score: 1},
+		{opponent: Glicko2{Rating: 1550, Deviation: 100}, score: 0},
+		{opponent: Glicko2{Rating: 1700, Deviation: 300}, score: 0},
+	}
+
+	updated := player.update(games, 0.5)
+
+	if math.Abs(updated.Rating-1464.06) > 0.01 || math.Abs(updated.Deviation-151.52) > 0.01 || math.Abs(updated.Volatility-0.05999) > 0.00001 {
+		t.Errorf(`TestGlicko2UpdateMatchesGlickmansExample expected 1464.06, 151.52 and 0.05999 but got %+v`, updated)
+	}
+}
+
+func TestGlicko2WithoutGamesGrowsLessCertain(t *testing.T) {
+	player := Glicko2{Rating: 1600, Deviation: 100, Volatility: 0.06}
+
+	updated := player.update(nil, DefaultGlicko2Tau)
+
+	if updated.Rating != player.Rating || updated.Deviation <= player.Deviation {
+		t.Errorf(`TestGlicko2WithoutGamesGrowsLessCertain expected the same rating with a larger deviation but got %+v`, updated)
+	}
+}
@@ -0,0 +1,198 @@
+// Package ratings keeps an Elo and a Glicko-2 rating for every registered strategy
+package ratings
+
+import (
+	"connect4/game"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strings"
+)
+
+const DefaultEloRating float64 = 1500
+
+// DefaultEloK is the most an Elo rating can move in one game
+const DefaultEloK float64 = 32
+
+// DefaultLadderFile is where the CLI keeps the ladder between runs
+const DefaultLadderFile string = "ratings.json"
+
+// Rating is everything the ladder knows about one strategy
+type Rating struct {
+	Elo     float64 `json:"elo"`
+	Glicko2 Glicko2 `json:"glicko2"`
+	Wins    int     `json:"wins"`
+	Draws   int     `json:"draws"`
+	Losses  int     `json:"losses"`
+}
+
+func NewDefaultRating() *Rating {
+	return &Rating{
+		Elo:     DefaultEloRating,
+		Glicko2: NewDefaultGlicko2(),
+	}
+}
+
+func (r Rating) Games() int {
+	return r.Wins + r.Draws + r.Losses
+}
+
+// Ladder rates strategies by their registered option names
+type Ladder struct {
+	Ratings map[string]*Rating `json:"ratings"`
+	EloK    float64            `json:"eloK"`
+	Tau     float64            `json:"tau"` // the Glicko-2 system constant
+}
+
+func NewLadder() *Ladder {
+	return &Ladder{
+		Ratings: make(map[string]*Rating),
+		EloK:    DefaultEloK,
+		Tau:     DefaultGlicko2Tau,
+	}
+}
+
+// Load reads a ladder saved by Save, a file that does not exist yet gives a new ladder
+func Load(path string) (*Ladder, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewLadder(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ladder := NewLadder()
+	if err := json.Unmarshal(data, ladder); err != nil {
+		return nil, fmt.Errorf("reading ratings from %s: %w", path, err)
+	}
+	if ladder.Ratings == nil {
+		ladder.Ratings = make(map[string]*Rating)
+	}
+
+	return ladder, nil
+}
+
+func (ladder *Ladder) Save(path string) error {
+	data, err := json.MarshalIndent(ladder, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Rating is the rating of the strategy, a strategy that has not played yet has the default rating
+func (ladder *Ladder) Rating(strategy string) *Rating {
+	rating, exists := ladder.Ratings[strategy]
+	if !exists {
+		rating = NewDefaultRating()
+		ladder.Ratings[strategy] = rating
+	}
+
+	return rating
+}
+
+// RecordGames rates a batch of games
+//
+// Elo ratings change after every game in order. The whole batch is one Glicko-2 rating period,
+// so every game is rated against the opponent's Glicko-2 rating from before the batch.
+// Games that did not start or were stopped in debug mode are skipped.
+func (ladder *Ladder) RecordGames(results []game.GameResult) {
+	before := make(map[string]Glicko2)
+	periods := make(map[string][]glicko2Game)
+
+	for _, result := range results {
+		if result.EndReason == game.GameEndNotStarted || result.EndReason == game.GameEndInvalidPosition {
+			continue
+		}
+
+		first := ladder.Rating(result.Strategies[0])
+		second := ladder.Rating(result.Strategies[1])
+		for _, strategy := range result.Strategies {
+			if _, exists := before[strategy]; !exists {
+				before[strategy] = ladder.Rating(strategy).Glicko2
+			}
+		}
+
+		score := 0.5
+		switch result.Winner {
+		case result.PlayerValues[0]:
+			score = 1
+			first.Wins++
+			second.Losses++
+		case result.PlayerValues[1]:
+			score = 0
+			first.Losses++
+			second.Wins++
+		default:
+			first.Draws++
+			second.Draws++
+		}
+
+		change := ladder.EloK * (score - eloExpectedScore(first.Elo, second.Elo))
+		first.Elo += change
+		second.Elo -= change
+
+		periods[result.Strategies[0]] = append(periods[result.Strategies[0]], glicko2Game{opponent: before[result.Strategies[1]], score: score})
+		periods[result.Strategies[1]] = append(periods[result.Strategies[1]], glicko2Game{opponent: before[result.Strategies[0]], score: 1 - score})
+	}
+
+	for strategy, games := range periods {
+		ladder.Ratings[strategy].Glicko2 = before[strategy].update(games, ladder.Tau)
+	}
+}
+
+// eloExpectedScore is the score a player rated rating expects against a player rated opponentRating
+func eloExpectedScore(rating float64, opponentRating float64) float64 {
+	return 1 / (1 + math.Pow(10, (opponentRating-rating)/400))
+}
+
+// Standing is a strategy's place on the ladder
+type Standing struct {
+	Strategy string
+	Rating
+}
+
+// Standings ranks the strategies by Glicko-2 rating, the ones not on the ladder yet have the default rating
+func (ladder *Ladder) Standings(strategies []string) []Standing {
+	standings := []Standing{}
+	for _, strategy := range strategies {
+		rating := NewDefaultRating()
+		if rated, exists := ladder.Ratings[strategy]; exists {
+			rating = rated
+		}
+		standings = append(standings, Standing{Strategy: strategy, Rating: *rating})
+	}
+
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		if a.Glicko2.Rating != b.Glicko2.Rating {
+			if a.Glicko2.Rating > b.Glicko2.Rating {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Strategy, b.Strategy)
+	})
+
+	return standings
+}
+
+// PrintLadder writes the standings of every registered automated strategy and every strategy on the ladder
+func (ladder *Ladder) PrintLadder(w io.Writer) {
+	strategies := game.GetRegisteredAutomatedPlayerStrategyNames()
+	for strategy := range ladder.Ratings {
+		if !slices.Contains(strategies, strategy) {
+			strategies = append(strategies, strategy)
+		}
+	}
+
+	fmt.Fprintf(w, "    %-16s %8s %8s %6s %6s  %s\n", "Strategy", "Glicko-2", "RD", "Elo", "Games", "W-D-L")
+	for place, standing := range ladder.Standings(strategies) {
+		fmt.Fprintf(w, "%2d. %-16s %8.0f %8.0f %6.0f %6d  %d-%d-%d\n", place+1, standing.Strategy, standing.Glicko2.Rating, standing.Glicko2.Deviation, standing.Elo, standing.Games(), standing.Wins, standing.Draws, standing.Losses)
+	}
+}
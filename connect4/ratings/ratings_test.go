@@ -0,0 +1,92 @@
+package ratings
+
+import (
+	"connect4/game"
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func gameResult(first string, second string, winner int) game.GameResult {
+	return game.GameResult{
+		Winner:       winner,
+		PlayerValues: [game.NumPlayers]int{1, 2},
+		Strategies:   [game.NumPlayers]string{first, second},
+		EndReason:    game.GameEndConnect,
+	}
+}
+
+func TestRecordGamesBetweenEqualRatings(t *testing.T) {
+	ladder := NewLadder()
+
+	ladder.RecordGames([]game.GameResult{gameResult("blocker", "random", 1)})
+
+	blocker := ladder.Rating("blocker")
+	random := ladder.Rating("random")
+	if blocker.Elo != DefaultEloRating+DefaultEloK/2 || random.Elo != DefaultEloRating-DefaultEloK/2 {
+		t.Errorf(`TestRecordGamesBetweenEqualRatings expected Elo ratings of 1516 and 1484 but got %v and %v`, blocker.Elo, random.Elo)
+	}
+	if blocker.Glicko2.Rating <= DefaultGlicko2Rating || math.Abs(blocker.Glicko2.Rating+random.Glicko2.Rating-2*DefaultGlicko2Rating) > 0.000001 {
+		t.Errorf(`TestRecordGamesBetweenEqualRatings expected Glicko-2 ratings either side of 1500 but got %v and %v`, blocker.Glicko2.Rating, random.Glicko2.Rating)
+	}
+	if blocker.Wins != 1 || random.Losses != 1 {
+		t.Errorf(`TestRecordGamesBetweenEqualRatings expected a win and a loss but got %+v and %+v`, blocker, random)
+	}
+}
+
+func TestRecordGamesSkipsGamesThatDidNotStart(t *testing.T) {
+	ladder := NewLadder()
+	result := gameResult("blocker", "random", game.NoPlayer)
+	result.EndReason = game.GameEndNotStarted
+
+	ladder.RecordGames([]game.GameResult{result})
+
+	if len(ladder.Ratings) != 0 {
+		t.Errorf(`TestRecordGamesSkipsGamesThatDidNotStart expected no ratings but got %v`, ladder.Ratings)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultLadderFile)
+	ladder := NewLadder()
+	ladder.RecordGames([]game.GameResult{gameResult("blocker", "random", 1), gameResult("random", "blocker", game.NoPlayer)})
+
+	if err := ladder.Save(path); err != nil {
+		t.Fatalf(`TestSaveAndLoad could not save: %v`, err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf(`TestSaveAndLoad could not load: %v`, err)
+	}
+
+	if !reflect.DeepEqual(ladder, loaded) {
+		t.Errorf(`TestSaveAndLoad expected %+v but loaded %+v`, ladder, loaded)
+	}
+}
+
+func TestLoadWithoutAFile(t *testing.T) {
+	ladder, err := Load(filepath.Join(t.TempDir(), DefaultLadderFile))
+
+	if err != nil || len(ladder.Ratings) != 0 {
+		t.Errorf(`TestLoadWithoutAFile expected a new ladder but got %v, %v`, ladder, err)
+	}
+}
+
+func TestPrintLadderRanksEveryRegisteredStrategy(t *testing.T) {
+	ladder := NewLadder()
+	ladder.RecordGames([]game.GameResult{gameResult("blocker", "random", 1), gameResult("random", "blocker", 2)})
+
+	var output strings.Builder
+	ladder.PrintLadder(&output)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != len(game.GetRegisteredAutomatedPlayerStrategyNames())+1 {
+		t.Errorf(`TestPrintLadderRanksEveryRegisteredStrategy expected a line per registered strategy but got %v`, output.String())
+	}
+	if !strings.HasPrefix(lines[1], " 1. blocker") || !strings.HasPrefix(lines[len(lines)-1], fmt.Sprintf("%2d. random", len(lines)-1)) {
+		t.Errorf(`TestPrintLadderRanksEveryRegisteredStrategy expected blocker first and random last but got %v`, output.String())
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"connect4/game"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func replay(args []string) {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: playconnect4 replay [flags] <file>")
+		fmt.Fprintln(flags.Output(), "  steps through a game record saved with --record or --archive")
+		flags.PrintDefaults()
+	}
+	argGame := flags.Int("game", 1, "Which game in the file to replay, counting from 1")
+	argPly := flags.Int("ply", 0, "Start after this many moves")
+	argSpeed := flags.Duration("speed", 0, "Play the game automatically with this long between moves, for example 500ms (default step through by hand)")
+	argRender := flags.String("render", game.RendererASCII, "How the board is drawn: "+strings.Join(game.RendererNames, ", "))
+	flags.Parse(args)
+
+	renderer, err := game.NewRenderer(*argRender)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	records, err := game.ReadGameRecords(file)
+	file.Close()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if *argGame < 1 || *argGame > len(records) {
+		fmt.Printf("game %d is not in %s, it has %d games\n", *argGame, flags.Arg(0), len(records))
+		os.Exit(1)
+	}
+
+	gameReplay, err := game.NewReplay(records[*argGame-1])
+	if err == nil {
+		err = gameReplay.JumpTo(*argPly)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	record := gameReplay.Record()
+	fmt.Printf("%s against %s, result %s\n\n", record.Player1, record.Player2, record.Result)
+	printReplay(gameReplay, renderer)
+
+	if *argSpeed > 0 {
+		autoPlay(gameReplay, renderer, *argSpeed)
+		return
+	}
+
+	stepThrough(gameReplay, renderer)
+}
+
+func printReplay(gameReplay *game.Replay, renderer game.Renderer) {
+	if lastTurn, ok := gameReplay.LastTurn(); ok {
+		fmt.Printf("Move %d of %d: Player %d plays column %d\n", gameReplay.Ply(), gameReplay.Plies(), lastTurn.PlayerValue, lastTurn.Column+1)
+	} else {
+		fmt.Printf("Move 0 of %d\n", gameReplay.Plies())
+	}
+
+	renderer.Render(os.Stdout, gameReplay.GameBoard().Snapshot())
+}
+
+func autoPlay(gameReplay *game.Replay, renderer game.Renderer, speed time.Duration) {
+	for gameReplay.Forward() {
+		time.Sleep(speed)
+		printReplay(gameReplay, renderer)
+	}
+}
+
+// stepThrough reads a command per line until the input ends or the player quits
+func stepThrough(gameReplay *game.Replay, renderer game.Renderer) {
+	const help = "Enter or n: next move, b: back a move, a number: jump to that move, a: auto play to the end, q: quit"
+	fmt.Println(help)
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print("> ")
+		if !scanner.Scan() {
+			return
+		}
+
+		command := strings.TrimSpace(scanner.Text())
+		switch command {
+		case "", "n":
+			if !gameReplay.Forward() {
+				fmt.Println("That was the last move")
+				continue
+			}
+		case "b":
+			if !gameReplay.Back() {
+				fmt.Println("That was the first move")
+				continue
+			}
+		case "a":
+			autoPlay(gameReplay, renderer, 500*time.Millisecond)
+			continue
+		case "q":
+			return
+		default:
+			ply, err := strconv.Atoi(command)
+			if err != nil {
+				fmt.Println(help)
+				continue
+			}
+			if err := gameReplay.JumpTo(ply); err != nil {
+				fmt.Println(err)
+				continue
+			}
+		}
+
+		printReplay(gameReplay, renderer)
+	}
+}
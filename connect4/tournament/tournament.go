@@ -0,0 +1,218 @@
+// Package tournament plays every pair of registered strategies against each other and ranks them
+package tournament
+
+import (
+	"connect4/game"
+	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+// Options chooses who plays in a tournament and how each game is set up
+type Options struct {
+	Strategies []string        // registered strategy names, every strategy not played by a person when empty
+	Rounds     int             // each round plays every pairing twice, once with each player moving first
+	Config     game.GameConfig // Player1, Player2 and Seed are set for each game
+	Seed       int64           // seeds every game of the tournament, zero means a random seed
+	Workers    int             // how many games are played at once, GOMAXPROCS when less than 1
+}
+
+func NewDefaultOptions() Options {
+	config := game.NewDefaultGameConfig()
+	config.ModuloToPrintGameBoard = 0
+	config.Output = nil
+
+	return Options{
+		Rounds: 1,
+		Config: config,
+	}
+}
+
+// Record is one strategy's wins, draws and losses
+type Record struct {
+	Wins   int
+	Draws  int
+	Losses int
+}
+
+// Points scores a win as 1 and a draw as a half
+func (r Record) Points() float64 {
+	return float64(r.Wins) + float64(r.Draws)/2
+}
+
+func (r Record) Games() int {
+	return r.Wins + r.Draws + r.Losses
+}
+
+func (r *Record) add(other Record) {
+	r.Wins += other.Wins
+	r.Draws += other.Draws
+	r.Losses += other.Losses
+}
+
+// Results holds the crosstable of a finished tournament
+type Results struct {
+	Strategies []string
+	// Crosstable[i][j] is the record of Strategies[i] against Strategies[j]
+	Crosstable [][]Record
+	Games      []game.GameResult // every game in the order it was scheduled
+	Seed       int64             // replays the tournament when set as Options.Seed
+}
+
+// Standing is a strategy's place on the leaderboard
+type Standing struct {
+	Strategy string
+	Record
+}
+
+// Run plays the round robin described by options
+func Run(options Options) (*Results, error) {
+	strategies := options.Strategies
+	if len(strategies) == 0 {
+		strategies = game.GetRegisteredAutomatedPlayerStrategyNames()
+	}
+
+	registered := game.GetRegisteredPlayerStrategyNames()
+	for _, strategy := range strategies {
+		if !slices.Contains(registered, strategy) {
+			return nil, fmt.Errorf("unknown strategy %q, %s", strategy, game.GetHelpMessageOfPlayerRegistry())
+		}
+	}
+	if len(strategies) < game.NumPlayers {
+		return nil, fmt.Errorf("a tournament needs at least %d strategies", game.NumPlayers)
+	}
+	if options.Rounds < 1 {
+		return nil, fmt.Errorf("a tournament needs at least 1 round")
+	}
+
+	results := &Results{
+		Strategies: strategies,
+		Crosstable: make([][]Record, len(strategies)),
+	}
+	for ndx := range strategies {
+		results.Crosstable[ndx] = make([]Record, len(strategies))
+	}
+
+	results.Seed = options.Seed
+	if results.Seed == 0 {
+		results.Seed = rand.Int63()
+	}
+
+	// pairings[i] holds the strategies playing configs[i], first to move first
+	pairings := [][2]int{}
+	configs := []game.GameConfig{}
+	for range options.Rounds {
+		for first := range strategies {
+			for second := range strategies {
+				if first == second {
+					continue
+				}
+
+				config := options.Config
+				config.Player1 = strategies[first]
+				config.Player2 = strategies[second]
+				config.Seed = game.GameSeed(results.Seed, len(configs))
+
+				pairings = append(pairings, [2]int{first, second})
+				configs = append(configs, config)
+			}
+		}
+	}
+
+	results.Games = game.PlayBatch(configs, options.Workers)
+	for gameNdx, result := range results.Games {
+		if err := results.record(result, pairings[gameNdx][0], pairings[gameNdx][1]); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// record one game with strategies[first] moving first in the crosstable
+func (results *Results) record(result game.GameResult, first int, second int) error {
+	switch {
+	case result.EndReason == game.GameEndNotStarted:
+		return fmt.Errorf("%s against %s could not start: %w", results.Strategies[first], results.Strategies[second], result.Err)
+	case result.EndReason == game.GameEndInvalidPosition:
+		return fmt.Errorf("%s against %s was stopped: %w", results.Strategies[first], results.Strategies[second], result.Err)
+	case result.Winner == result.PlayerValues[0]:
+		results.Crosstable[first][second].Wins++
+		results.Crosstable[second][first].Losses++
+	case result.Winner == result.PlayerValues[1]:
+		results.Crosstable[first][second].Losses++
+		results.Crosstable[second][first].Wins++
+	default:
+		results.Crosstable[first][second].Draws++
+		results.Crosstable[second][first].Draws++
+	}
+
+	return nil
+}
+
+// Leaderboard totals each strategy's record, most points first
+func (results *Results) Leaderboard() []Standing {
+	standings := make([]Standing, len(results.Strategies))
+	for ndx, strategy := range results.Strategies {
+		standings[ndx].Strategy = strategy
+		for _, record := range results.Crosstable[ndx] {
+			standings[ndx].add(record)
+		}
+	}
+
+	slices.SortStableFunc(standings, func(a, b Standing) int {
+		if a.Points() != b.Points() {
+			if a.Points() > b.Points() {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Strategy, b.Strategy)
+	})
+
+	return standings
+}
+
+// PrintCrosstable writes each strategy's wins-draws-losses against every other strategy, one row per strategy
+func (results *Results) PrintCrosstable(w io.Writer) {
+	nameWidth := len("Strategy")
+	cellWidth := len("W-D-L")
+	for ndx, strategy := range results.Strategies {
+		nameWidth = max(nameWidth, len(strategy))
+		for _, record := range results.Crosstable[ndx] {
+			cellWidth = max(cellWidth, len(formatRecord(record)))
+		}
+	}
+	cellWidth = max(cellWidth, len(fmt.Sprint(len(results.Strategies))))
+
+	fmt.Fprintf(w, "%-*s", nameWidth+4, "Strategy")
+	for ndx := range results.Strategies {
+		fmt.Fprintf(w, " %*d", cellWidth, ndx+1)
+	}
+	fmt.Fprintln(w)
+
+	for ndx, strategy := range results.Strategies {
+		fmt.Fprintf(w, "%2d. %-*s", ndx+1, nameWidth, strategy)
+		for opponent, record := range results.Crosstable[ndx] {
+			cell := formatRecord(record)
+			if opponent == ndx {
+				cell = "-"
+			}
+			fmt.Fprintf(w, " %*s", cellWidth, cell)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// PrintLeaderboard writes the standings, most points first
+func (results *Results) PrintLeaderboard(w io.Writer) {
+	for place, standing := range results.Leaderboard() {
+		fmt.Fprintf(w, "%2d. %-16s %5.1f points (%d wins, %d draws, %d losses)\n", place+1, standing.Strategy, standing.Points(), standing.Wins, standing.Draws, standing.Losses)
+	}
+}
+
+func formatRecord(record Record) string {
+	return fmt.Sprintf("%d-%d-%d", record.Wins, record.Draws, record.Losses)
+}
@@ -0,0 +1,127 @@
+package tournament
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRunPlaysEveryPairingWithColoursSwapped(t *testing.T) {
+	options := NewDefaultOptions()
+	options.Strategies = []string{"firstavailable", "blocker", "random"}
+	options.Rounds = 2
+
+	results, err := Run(options)
+	if err != nil {
+		t.Fatalf(`TestRunPlaysEveryPairingWithColoursSwapped returned error %v`, err)
+	}
+
+	for ndx, strategy := range results.Strategies {
+		for opponentNdx, opponent := range results.Strategies {
+			record := results.Crosstable[ndx][opponentNdx]
+			mirror := results.Crosstable[opponentNdx][ndx]
+
+			expectedGames := 2 * options.Rounds
+			if ndx == opponentNdx {
+				expectedGames = 0
+			}
+			if record.Games() != expectedGames {
+				t.Errorf(`TestRunPlaysEveryPairingWithColoursSwapped expected %s to play %s %d times but got %+v`, strategy, opponent, expectedGames, record)
+			}
+			if record.Wins != mirror.Losses || record.Draws != mirror.Draws {
+				t.Errorf(`TestRunPlaysEveryPairingWithColoursSwapped %s against %s is %+v but the mirror is %+v`, strategy, opponent, record, mirror)
+			}
+		}
+	}
+}
+
+func TestRunWithASeedGivesTheSameCrosstableInParallel(t *testing.T) {
+	options := NewDefaultOptions()
+	options.Strategies = []string{"random", "blocker", "mcts"}
+	options.Seed = 2024
+	options.Workers = 1
+
+	serial, err := Run(options)
+	if err != nil {
+		t.Fatalf(`TestRunWithASeedGivesTheSameCrosstableInParallel returned error %v`, err)
+	}
+
+	options.Workers = 6
+	parallel, _ := Run(options)
+
+	if !reflect.DeepEqual(serial.Crosstable, parallel.Crosstable) {
+		t.Errorf(`TestRunWithASeedGivesTheSameCrosstableInParallel expected %v but got %v`, serial.Crosstable, parallel.Crosstable)
+	}
+}
+
+func TestRunReplaysFromItsSeed(t *testing.T) {
+	options := NewDefaultOptions()
+	options.Strategies = []string{"random", "firstavailable"}
+	options.Rounds = 3
+
+	results, err := Run(options)
+	if err != nil {
+		t.Fatalf(`TestRunReplaysFromItsSeed returned error %v`, err)
+	}
+
+	options.Seed = results.Seed
+	replay, _ := Run(options)
+
+	for gameNdx, result := range results.Games {
+		if replay.Games[gameNdx].Seed != result.Seed || !reflect.DeepEqual(replay.Games[gameNdx].Turns, result.Turns) {
+			t.Errorf(`TestRunReplaysFromItsSeed game %d was %v but the replay was %v`, gameNdx, result.Turns, replay.Games[gameNdx].Turns)
+		}
+	}
+}
+
+func TestLeaderboardIsSortedByPoints(t *testing.T) {
+	results := &Results{
+		Strategies: []string{"a", "b", "c"},
+		Crosstable: [][]Record{
+			{{}, {Losses: 2}, {Draws: 2}},
+			{{Wins: 2}, {}, {Wins: 1, Losses: 1}},
+			{{Draws: 2}, {Wins: 1, Losses: 1}, {}},
+		},
+	}
+
+	standings := results.Leaderboard()
+
+	expected := []string{"b", "c", "a"}
+	for place, standing := range standings {
+		if standing.Strategy != expected[place] {
+			t.Errorf(`TestLeaderboardIsSortedByPoints expected %s in place %d but got %s`, expected[place], place+1, standing.Strategy)
+		}
+	}
+	if standings[0].Points() != 3 || standings[1].Points() != 2 {
+		t.Errorf(`TestLeaderboardIsSortedByPoints expected 3 and 2 points but got %v and %v`, standings[0].Points(), standings[1].Points())
+	}
+}
+
+func TestPrintCrosstable(t *testing.T) {
+	results := &Results{
+		Strategies: []string{"random", "blocker"},
+		Crosstable: [][]Record{
+			{{}, {Wins: 1, Draws: 2, Losses: 3}},
+			{{Wins: 3, Draws: 2, Losses: 1}, {}},
+		},
+	}
+
+	var output strings.Builder
+	results.PrintCrosstable(&output)
+
+	expected := "Strategy         1     2\n" +
+		" 1. random       - 1-2-3\n" +
+		" 2. blocker  3-2-1     -\n"
+	if output.String() != expected {
+		t.Errorf("TestPrintCrosstable expected\n%s\nbut got\n%s", expected, output.String())
+	}
+}
+
+func TestRunRejectsUnknownStrategy(t *testing.T) {
+	options := NewDefaultOptions()
+	options.Strategies = []string{"random", "nosuchstrategy"}
+
+	if _, err := Run(options); err == nil || !strings.Contains(err.Error(), "nosuchstrategy") {
+		t.Errorf(`TestRunRejectsUnknownStrategy expected an unknown strategy error but got %v`, err)
+	}
+}